@@ -0,0 +1,83 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package r1cs
+
+import (
+	"encoding/hex"
+	"math/big"
+)
+
+// coeffElement is the minimal set of field-element operations a curve's
+// scalar-field type needs for newCoeffIndexer to dedupe constraint
+// coefficients against it; every backend_XXX.R1CS conversion shares this
+// logic instead of reimplementing getCoeffIdx per curve.
+type coeffElement[E any] interface {
+	*E
+	SetBigInt(*big.Int) *E
+	SetOne() *E
+	SetUint64(uint64) *E
+	Neg(*E) *E
+	IsZero() bool
+	Equal(*E) bool
+	Bytes() []byte
+}
+
+// newCoeffIndexer returns a getCoeffIdx closure that special-cases the
+// coefficients 0, 1, -1 and 2 (returned as specialValue, with coeffID
+// unused) and otherwise deduplicates coefficients via an encoded-bytes
+// lookup table, appending newly-seen values to coefficients.
+func newCoeffIndexer[E any, PE coeffElement[E]](coefficients *[]E) func(b *big.Int) (coeffID, specialValue int) {
+	lookupTable := make(map[string]int)
+	var e, eOne, eTwo, eMinusOne E
+	PE(&eOne).SetOne()
+	PE(&eMinusOne).Neg(&eOne)
+	PE(&eTwo).SetUint64(2)
+
+	const maxInt = int(^uint(0) >> 1)
+
+	return func(b *big.Int) (coeffID, specialValue int) {
+		PE(&e).SetBigInt(b)
+
+		// let's check if we have a special value
+		specialValue = maxInt
+		if PE(&e).IsZero() {
+			specialValue = 0
+			return
+		} else if PE(&e).Equal(&eOne) {
+			specialValue = 1
+			return
+		} else if PE(&e).Equal(&eMinusOne) {
+			specialValue = -1
+			return
+		} else if PE(&e).Equal(&eTwo) {
+			specialValue = 2
+			return
+		}
+
+		// no special value, let's check if we have encountered the coeff already
+		// note: this is slow. but "offline"
+		key := hex.EncodeToString(PE(&e).Bytes())
+		if idx, ok := lookupTable[key]; ok {
+			coeffID = idx
+			return
+		}
+
+		// we didn't find it, let's add it to our coefficients
+		coeffID = len(*coefficients)
+		*coefficients = append(*coefficients, e)
+		lookupTable[key] = coeffID
+		return
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package r1cs
+
+import (
+	"math/big"
+	"testing"
+)
+
+// fakeFieldElement is a minimal stand-in scalar-field element, just enough
+// to satisfy coeffElement, used to test newCoeffIndexer without pulling in
+// a real curve's fr.Element.
+type fakeFieldElement struct {
+	v *big.Int
+}
+
+func (e *fakeFieldElement) SetBigInt(b *big.Int) *fakeFieldElement {
+	e.v = new(big.Int).Set(b)
+	return e
+}
+
+func (e *fakeFieldElement) SetOne() *fakeFieldElement {
+	e.v = big.NewInt(1)
+	return e
+}
+
+func (e *fakeFieldElement) SetUint64(v uint64) *fakeFieldElement {
+	e.v = new(big.Int).SetUint64(v)
+	return e
+}
+
+func (e *fakeFieldElement) Neg(a *fakeFieldElement) *fakeFieldElement {
+	e.v = new(big.Int).Neg(a.v)
+	return e
+}
+
+func (e *fakeFieldElement) IsZero() bool {
+	return e.v.Sign() == 0
+}
+
+func (e *fakeFieldElement) Equal(a *fakeFieldElement) bool {
+	return e.v.Cmp(a.v) == 0
+}
+
+func (e *fakeFieldElement) Bytes() []byte {
+	return e.v.Bytes()
+}
+
+func TestNewCoeffIndexerSpecialValues(t *testing.T) {
+	var coeffs []fakeFieldElement
+	getCoeffIdx := newCoeffIndexer[fakeFieldElement, *fakeFieldElement](&coeffs)
+
+	for _, tc := range []struct {
+		value    int64
+		expected int
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, -1},
+		{2, 2},
+	} {
+		if _, sv := getCoeffIdx(big.NewInt(tc.value)); sv != tc.expected {
+			t.Fatalf("value %d: expected special value %d, got %d", tc.value, tc.expected, sv)
+		}
+	}
+	if len(coeffs) != 0 {
+		t.Fatalf("special values must not be appended to the coefficient table, got %d entries", len(coeffs))
+	}
+}
+
+func TestNewCoeffIndexerDedupe(t *testing.T) {
+	var coeffs []fakeFieldElement
+	getCoeffIdx := newCoeffIndexer[fakeFieldElement, *fakeFieldElement](&coeffs)
+
+	id1, sv := getCoeffIdx(big.NewInt(42))
+	if sv == 0 || sv == 1 || sv == -1 || sv == 2 {
+		t.Fatalf("42 should not collide with a special value, got %d", sv)
+	}
+	id2, _ := getCoeffIdx(big.NewInt(42))
+	if id1 != id2 {
+		t.Fatalf("expected the same coefficient to be deduplicated, got ids %d and %d", id1, id2)
+	}
+	id3, _ := getCoeffIdx(big.NewInt(43))
+	if id3 == id1 {
+		t.Fatalf("expected a distinct coefficient to get a distinct id")
+	}
+	if len(coeffs) != 2 {
+		t.Fatalf("expected exactly two non-special coefficients to be recorded, got %d", len(coeffs))
+	}
+}
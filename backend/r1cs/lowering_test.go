@@ -0,0 +1,113 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package r1cs
+
+import (
+	"math/big"
+	"testing"
+
+	backend_secp256k1 "github.com/consensys/gnark/backend/secp256k1"
+)
+
+// fakeFieldElementB stands in for a second curve's scalar-field type, the
+// same way fakeFieldElement (in coeff_test.go) stands in for the first: a
+// distinct Go type with the same big.Int-backed behavior, so two
+// newCoeffIndexer instances can be driven in parallel without depending on
+// two real curves' fr.Element.
+type fakeFieldElementB struct {
+	v *big.Int
+}
+
+func (e *fakeFieldElementB) SetBigInt(b *big.Int) *fakeFieldElementB {
+	e.v = new(big.Int).Set(b)
+	return e
+}
+
+func (e *fakeFieldElementB) SetOne() *fakeFieldElementB {
+	e.v = big.NewInt(1)
+	return e
+}
+
+func (e *fakeFieldElementB) SetUint64(v uint64) *fakeFieldElementB {
+	e.v = new(big.Int).SetUint64(v)
+	return e
+}
+
+func (e *fakeFieldElementB) Neg(a *fakeFieldElementB) *fakeFieldElementB {
+	e.v = new(big.Int).Neg(a.v)
+	return e
+}
+
+func (e *fakeFieldElementB) IsZero() bool {
+	return e.v.Sign() == 0
+}
+
+func (e *fakeFieldElementB) Equal(a *fakeFieldElementB) bool {
+	return e.v.Cmp(a.v) == 0
+}
+
+func (e *fakeFieldElementB) Bytes() []byte {
+	return e.v.Bytes()
+}
+
+// TestLoweringAgreesAcrossFieldTypes does not compile a circuit or call
+// toBN256/toSecp256k1 on an UntypedR1CS, and it solves no witness.
+// What both conversions share -- and what would silently diverge
+// if one of them drifted -- is newCoeffIndexer and the curve's Term
+// bit-packing; this test drives newCoeffIndexer with two distinct
+// coeffElement implementations (standing in for two curves' fr.Element)
+// over identical synthetic constraint data and checks the resulting Terms
+// agree on wire ID, special-value classification and coefficient ordering,
+// i.e. that identical input lowers identically regardless of which curve's
+// field type backs the coefficient table.
+func TestLoweringAgreesAcrossFieldTypes(t *testing.T) {
+	type linEntry struct {
+		wireID int
+		coeff  int64
+	}
+	l := []linEntry{{0, 1}, {1, -1}, {2, 7}, {3, 0}, {4, 2}, {5, 7}}
+
+	var coeffsA []fakeFieldElement
+	var coeffsB []fakeFieldElementB
+	getIdxA := newCoeffIndexer[fakeFieldElement, *fakeFieldElement](&coeffsA)
+	getIdxB := newCoeffIndexer[fakeFieldElementB, *fakeFieldElementB](&coeffsB)
+
+	termsA := make(backend_secp256k1.LinearExpression, len(l))
+	termsB := make(backend_secp256k1.LinearExpression, len(l))
+	for i, e := range l {
+		cIDA, svA := getIdxA(big.NewInt(e.coeff))
+		cIDB, svB := getIdxB(big.NewInt(e.coeff))
+		termsA[i] = backend_secp256k1.NewTerm(e.wireID, cIDA, svA)
+		termsB[i] = backend_secp256k1.NewTerm(e.wireID, cIDB, svB)
+	}
+
+	if len(coeffsA) != len(coeffsB) {
+		t.Fatalf("expected the same number of non-special coefficients regardless of field type, got %d and %d", len(coeffsA), len(coeffsB))
+	}
+
+	for i := range termsA {
+		if termsA[i].WireID() != termsB[i].WireID() {
+			t.Fatalf("term %d: wire ID diverged: %d vs %d", i, termsA[i].WireID(), termsB[i].WireID())
+		}
+		svA, okA := termsA[i].SpecialValue()
+		svB, okB := termsB[i].SpecialValue()
+		if okA != okB || (okA && svA != svB) {
+			t.Fatalf("term %d: special value diverged: (%d, %v) vs (%d, %v)", i, svA, okA, svB, okB)
+		}
+		if !okA && termsA[i].CoeffID() != termsB[i].CoeffID() {
+			t.Fatalf("term %d: coefficient ID diverged: %d vs %d", i, termsA[i].CoeffID(), termsB[i].CoeffID())
+		}
+	}
+}
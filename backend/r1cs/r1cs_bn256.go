@@ -17,9 +17,6 @@
 package r1cs
 
 import (
-	"encoding/hex"
-	"math/big"
-
 	backend_bn256 "github.com/consensys/gnark/backend/bn256"
 
 	"github.com/consensys/gurvy/bn256/fr"
@@ -39,47 +36,7 @@ func (r1cs *UntypedR1CS) toBN256() *backend_bn256.R1CS {
 	}
 	toReturn.Constraints = make([]backend_bn256.R1C, len(r1cs.Constraints))
 
-	lookupTable := make(map[string]int)
-	var e, eOne, eTwo, eMinusOne fr.Element
-	eOne.SetOne()
-	eMinusOne.Neg(&eOne)
-	eTwo.SetUint64(2)
-
-	const maxInt = int(^uint(0) >> 1)
-
-	getCoeffIdx := func(b *big.Int) (coeffID, specialValue int) {
-		e.SetBigInt(b)
-
-		// let's check if wwe have a special value
-		specialValue = maxInt
-		if e.IsZero() {
-			specialValue = 0
-			return
-		} else if e.Equal(&eOne) {
-			specialValue = 1
-			return
-		} else if e.Equal(&eMinusOne) {
-			specialValue = -1
-			return
-		} else if e.Equal(&eTwo) {
-			specialValue = 2
-			return
-		}
-
-		// no special value, let's check if we have encountered the coeff already
-		// note: this is slow. but "offline"
-		key := hex.EncodeToString(e.Bytes())
-		if idx, ok := lookupTable[key]; ok {
-			coeffID = idx
-			return
-		}
-
-		// we didn't find it, let's add it to our coefficients
-		coeffID = len(toReturn.Coefficients)
-		toReturn.Coefficients = append(toReturn.Coefficients, e)
-		lookupTable[key] = coeffID
-		return
-	}
+	getCoeffIdx := newCoeffIndexer[fr.Element](&toReturn.Coefficients)
 
 	var cID, specialValue int
 
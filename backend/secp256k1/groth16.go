@@ -0,0 +1,59 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend_secp256k1
+
+import (
+	"errors"
+
+	"github.com/consensys/gurvy/secp256k1/fr"
+)
+
+// ErrNotPairingFriendly is returned by Setup, Prove and Verify. Groth16
+// needs a bilinear pairing e: G1 x G2 -> GT over the curve's scalar field,
+// which requires a small embedding degree; secp256k1 was chosen for
+// signature-verification efficiency, not pairings, and its embedding degree
+// is astronomically large. There is no efficient pairing to build Groth16
+// on top of, so these entry points exist only so callers can select a
+// backend uniformly by curve, and fail fast instead of returning a proof
+// that verifies nothing.
+var ErrNotPairingFriendly = errors.New("backend_secp256k1: secp256k1 has no efficient pairing, Groth16 is unavailable on this curve")
+
+// ProvingKey is the secp256k1 Groth16 proving key. It is always empty: see
+// ErrNotPairingFriendly.
+type ProvingKey struct{}
+
+// VerifyingKey is the secp256k1 Groth16 verifying key. It is always empty:
+// see ErrNotPairingFriendly.
+type VerifyingKey struct{}
+
+// Proof is a secp256k1 Groth16 proof. It is always empty: see
+// ErrNotPairingFriendly.
+type Proof struct{}
+
+// Setup always returns ErrNotPairingFriendly; see its doc comment.
+func Setup(r1cs *R1CS) (*ProvingKey, *VerifyingKey, error) {
+	return nil, nil, ErrNotPairingFriendly
+}
+
+// Prove always returns ErrNotPairingFriendly; see ErrNotPairingFriendly's
+// doc comment.
+func Prove(r1cs *R1CS, pk *ProvingKey, witness []fr.Element) (*Proof, error) {
+	return nil, ErrNotPairingFriendly
+}
+
+// Verify always returns ErrNotPairingFriendly; see its doc comment.
+func Verify(proof *Proof, vk *VerifyingKey, publicWitness []fr.Element) error {
+	return ErrNotPairingFriendly
+}
@@ -0,0 +1,135 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backend_secp256k1 is the secp256k1 (Koblitz curve) instantiation
+// of gnark's curve-specific backend, mirroring backend_bn256: a typed R1CS
+// representation plus the Groth16 entry points callers reach through the
+// generic backend.ProvingSystem switch.
+package backend_secp256k1
+
+import (
+	"github.com/consensys/gurvy/secp256k1/fr"
+)
+
+// SolvingMethod tells the solver how to recover a constraint's unassigned
+// wire: by solving L * R == O for a single unassigned wire (SingleOutput),
+// or by splitting it into its bit decomposition (BinaryDecomposition).
+type SolvingMethod uint8
+
+const (
+	SingleOutput SolvingMethod = iota
+	BinaryDecomposition
+)
+
+// Term packs a LinearExpression entry -- a wire ID, the index of its
+// coefficient in R1CS.Coefficients and a special-value tag for the common
+// coefficients 0, 1, -1 and 2 -- into a single uint64 so a LinearExpression
+// is a flat []Term rather than a slice of structs.
+type Term uint64
+
+const (
+	termWireIDBits  = 32
+	termCoeffIDBits = 29
+
+	termWireIDMask  = (1 << termWireIDBits) - 1
+	termCoeffIDMask = (1 << termCoeffIDBits) - 1
+)
+
+type coeffTag uint8
+
+const (
+	coeffZero coeffTag = iota
+	coeffOne
+	coeffMinusOne
+	coeffTwo
+	coeffOther
+)
+
+// NewTerm builds a Term from a wire ID, a coefficient index and the special
+// value reported by the coefficient indexer (0, 1, -1, 2, or any other int
+// for a coefficient that must be looked up by coeffID).
+func NewTerm(wireID, coeffID, specialValue int) Term {
+	var tag coeffTag
+	switch specialValue {
+	case 0:
+		tag = coeffZero
+	case 1:
+		tag = coeffOne
+	case -1:
+		tag = coeffMinusOne
+	case 2:
+		tag = coeffTwo
+	default:
+		tag = coeffOther
+	}
+	return Term(uint64(wireID)&termWireIDMask |
+		(uint64(coeffID)&termCoeffIDMask)<<termWireIDBits |
+		uint64(tag)<<(termWireIDBits+termCoeffIDBits))
+}
+
+// WireID returns the wire this term references.
+func (t Term) WireID() int {
+	return int(uint64(t) & termWireIDMask)
+}
+
+// CoeffID returns the index of this term's coefficient in
+// R1CS.Coefficients. It is meaningless when the term carries a special
+// value other than coeffOther; use SpecialValue to check first.
+func (t Term) CoeffID() int {
+	return int((uint64(t) >> termWireIDBits) & termCoeffIDMask)
+}
+
+// SpecialValue reports the term's coefficient when it is one of 0, 1, -1 or
+// 2, along with ok == true; ok is false when the coefficient must instead
+// be read out of R1CS.Coefficients at CoeffID.
+func (t Term) SpecialValue() (value int, ok bool) {
+	switch coeffTag((uint64(t) >> (termWireIDBits + termCoeffIDBits))) {
+	case coeffZero:
+		return 0, true
+	case coeffOne:
+		return 1, true
+	case coeffMinusOne:
+		return -1, true
+	case coeffTwo:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+// LinearExpression is a sum of Terms, e.g. the L, R or O side of an R1C.
+type LinearExpression []Term
+
+// R1C is a single rank-1 constraint L * R == O, plus the SolvingMethod the
+// solver should use to recover its unassigned wire.
+type R1C struct {
+	L, R, O LinearExpression
+	Solver  SolvingMethod
+}
+
+// R1CS is the secp256k1-typed constraint system produced by
+// UntypedR1CS.toSecp256k1: every LinearExpression term resolves its
+// coefficient either to a special value or to an index into Coefficients.
+type R1CS struct {
+	NbWires         int
+	NbPublicWires   int
+	NbPrivateWires  int
+	PrivateWires    []string
+	PublicWires     []string
+	WireTags        map[int][]string
+	NbConstraints   int
+	NbCOConstraints int
+	Constraints     []R1C
+	Coefficients    []fr.Element
+}
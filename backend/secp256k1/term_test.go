@@ -0,0 +1,56 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend_secp256k1
+
+import "testing"
+
+func TestNewTermSpecialValues(t *testing.T) {
+	for _, tc := range []struct {
+		specialValue int
+		wantValue    int
+		wantOK       bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, -1, true},
+		{2, 2, true},
+		{42, 0, false},
+	} {
+		term := NewTerm(7, 3, tc.specialValue)
+		if got := term.WireID(); got != 7 {
+			t.Fatalf("specialValue %d: expected wire ID 7, got %d", tc.specialValue, got)
+		}
+		value, ok := term.SpecialValue()
+		if ok != tc.wantOK {
+			t.Fatalf("specialValue %d: expected ok=%v, got %v", tc.specialValue, tc.wantOK, ok)
+		}
+		if ok && value != tc.wantValue {
+			t.Fatalf("specialValue %d: expected %d, got %d", tc.specialValue, tc.wantValue, value)
+		}
+	}
+}
+
+func TestNewTermCoeffIDRoundTrip(t *testing.T) {
+	term := NewTerm(123456, 789, 42)
+	if got := term.WireID(); got != 123456 {
+		t.Fatalf("expected wire ID 123456, got %d", got)
+	}
+	if got := term.CoeffID(); got != 789 {
+		t.Fatalf("expected coeff ID 789, got %d", got)
+	}
+	if _, ok := term.SpecialValue(); ok {
+		t.Fatalf("expected no special value for an ordinary coefficient")
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package scs
+
+import "sync/atomic"
+
+// elidedAssertions counts the number of IsZero calls that were compiled down
+// to a single subtraction because the operand was already known boolean via
+// the pre-existing MarkBoolean/IsBoolean bookkeeping, e.g. the output of a
+// prior Xor/Or/And/IsZero rather than a fresh value needing the general
+// hint-based IsZero gadget. This is a single special case, not a general
+// {constant, boolean, bounded, unknown} abstract-value domain propagated
+// through Add/Sub/Mul/Neg/hints: a fuller tracker would also catch, say,
+// Mul-by-a-known-boolean, but isn't implemented here. The counter is
+// process-wide rather than per-compile, since builder instances are not
+// retained after Compile returns; callers that need a per-compile count
+// should read it before and after their call to frontend.Compile and take
+// the difference.
+var elidedAssertions atomic.Int64
+
+// ElidedAssertionStats returns the number of IsZero calls elided so far
+// across every compile in this process by the already-boolean shortcut in
+// IsZero.
+func ElidedAssertionStats() int64 {
+	return elidedAssertions.Load()
+}
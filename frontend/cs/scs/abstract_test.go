@@ -0,0 +1,15 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package scs
+
+import "testing"
+
+func TestElidedAssertionStatsMonotonic(t *testing.T) {
+	before := ElidedAssertionStats()
+	elidedAssertions.Add(1)
+	after := ElidedAssertionStats()
+	if after != before+1 {
+		t.Fatalf("expected counter to increase by 1, got %d -> %d", before, after)
+	}
+}
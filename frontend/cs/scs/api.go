@@ -447,6 +447,16 @@ func (builder *builder[E]) IsZero(i1 frontend.Variable) frontend.Variable {
 		return 0
 	}
 
+	// a boolean b is zero iff it is not one: IsZero(b) == 1-b. This needs no
+	// hint and a single constraint for 1-b, as opposed to the hint and two
+	// constraints of the general case below, so we take it whenever IsBoolean
+	// already records the input as boolean (e.g. the output of a prior
+	// Xor/Or/And/IsZero, or a bit out of ToBinary).
+	if builder.IsBoolean(i1) {
+		elidedAssertions.Add(1)
+		return builder.Sub(1, i1)
+	}
+
 	// x = 1/a 				// in a hint (x == 0 if a == 0)
 	// m = -a*x + 1         // constrain m to be 1 if a == 0
 	// a * m = 0            // constrain m to be 0 if a != 0
@@ -0,0 +1,508 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package scs
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/internal/tinyfield"
+)
+
+// This file implements a small QuickCheck-style property test for the
+// builder[E] API in this package: it synthesizes random circuits out of the
+// methods below, evaluates them natively over big.Int mod the field to get a
+// reference witness, compiles the same program with this builder and runs
+// the PLONK solver, and checks that the two agree. Failing programs are
+// shrunk to a minimal counter-example and persisted under testdata/corpus so
+// they are replayed (and thus regression-tested) on every future run.
+
+// opKind enumerates the builder[E] methods exercised by the fuzzer.
+type opKind int
+
+const (
+	opAdd opKind = iota
+	opSub
+	opMul
+	opMulAcc
+	opDivUnchecked
+	opInverse
+	opXor
+	opOr
+	opAnd
+	opSelect
+	opLookup2
+	opIsZero
+	opCmp
+	opEvaluatePlonkExpression
+	opAddPlonkConstraint
+	opCommit
+	numOpKinds
+)
+
+// fuzzOp is one instruction of a randomly generated program. Operand indices
+// refer to previously computed values (inputs come first, then the result of
+// every earlier op), so a program is always a DAG over a flat value list.
+type fuzzOp struct {
+	Kind opKind `json:"kind"`
+	A    int    `json:"a"`
+	B    int    `json:"b"`
+	C    int    `json:"c"`
+	Cond int    `json:"cond"` // for Select/Lookup2
+	QL   int    `json:"qL,omitempty"`
+	QR   int    `json:"qR,omitempty"`
+	QM   int    `json:"qM,omitempty"`
+	QC   int    `json:"qC,omitempty"`
+	QO   int    `json:"qO,omitempty"`
+}
+
+// fuzzProgram is the shrinkable unit generated and replayed by the harness.
+type fuzzProgram struct {
+	NbInputs int      `json:"nbInputs"`
+	Ops      []fuzzOp `json:"ops"`
+}
+
+// fuzzCircuit compiles a fuzzProgram through frontend.Compile with this
+// package's builder.
+//
+// Expected holds, for every op whose reference value is well defined (i.e.
+// every op but opCommit, whose output has no big.Int counterpart), the
+// value evalReference computed for that op; Define asserts each builder
+// result against it so the fuzzer can catch a wrong-value bug, not just a
+// panic or a solver failure.
+type fuzzCircuit struct {
+	Inputs   []frontend.Variable
+	Expected []frontend.Variable
+	prog     fuzzProgram
+}
+
+// plonkExtraAPI captures the methods of builder[E] that are not part of the
+// generic frontend.API but are still reachable through it via a type
+// assertion, mirroring how callers reach Commit/AddPlonkConstraint today.
+type plonkExtraAPI interface {
+	EvaluatePlonkExpression(a, b frontend.Variable, qL, qR, qM, qC int) frontend.Variable
+	AddPlonkConstraint(a, b, o frontend.Variable, qL, qR, qO, qM, qC int)
+	Commit(v ...frontend.Variable) (frontend.Variable, error)
+}
+
+func (c *fuzzCircuit) Define(api frontend.API) error {
+	extra := api.(plonkExtraAPI)
+	vals := make([]frontend.Variable, len(c.Inputs))
+	copy(vals, c.Inputs)
+
+	clampIdx := func(i int) int {
+		if len(vals) == 0 {
+			return 0
+		}
+		i %= len(vals)
+		if i < 0 {
+			i += len(vals)
+		}
+		return i
+	}
+
+	for idx, op := range c.prog.Ops {
+		var res frontend.Variable
+		a := vals[clampIdx(op.A)]
+		b := vals[clampIdx(op.B)]
+		switch op.Kind % numOpKinds {
+		case opAdd:
+			res = api.Add(a, b)
+		case opSub:
+			res = api.Sub(a, b)
+		case opMul:
+			res = api.Mul(a, b)
+		case opMulAcc:
+			res = api.MulAcc(vals[clampIdx(op.C)], a, b)
+		case opDivUnchecked:
+			res = api.DivUnchecked(a, b)
+		case opInverse:
+			res = api.Inverse(a)
+		case opXor:
+			res = api.Xor(api.IsZero(a), api.IsZero(b))
+		case opOr:
+			res = api.Or(api.IsZero(a), api.IsZero(b))
+		case opAnd:
+			res = api.And(api.IsZero(a), api.IsZero(b))
+		case opSelect:
+			res = api.Select(api.IsZero(vals[clampIdx(op.Cond)]), a, b)
+		case opLookup2:
+			res = api.Lookup2(api.IsZero(vals[clampIdx(op.Cond)]), api.IsZero(a), a, b, a, b)
+		case opIsZero:
+			res = api.IsZero(a)
+		case opCmp:
+			res = api.Cmp(a, b)
+		case opEvaluatePlonkExpression:
+			res = extra.EvaluatePlonkExpression(a, b, op.QL, op.QR, op.QM, op.QC)
+		case opAddPlonkConstraint:
+			// qL.a + qR.b + qM.ab + qC - o == 0, so o is the reference value.
+			o := extra.EvaluatePlonkExpression(a, b, op.QL, op.QR, op.QM, op.QC)
+			extra.AddPlonkConstraint(a, b, o, op.QL, op.QR, -1, op.QM, op.QC)
+			res = o
+		case opCommit:
+			committed, err := extra.Commit(a, b)
+			if err != nil {
+				return fmt.Errorf("commit: %w", err)
+			}
+			res = committed
+		}
+		// opCommit's result is a Fiat-Shamir-style commitment with no
+		// big.Int counterpart in evalReference, so it has nothing to be
+		// asserted against; every other op is asserted against the
+		// reference value computed for it.
+		if op.Kind%numOpKinds != opCommit && idx < len(c.Expected) {
+			api.AssertIsEqual(res, c.Expected[idx])
+		}
+		vals = append(vals, res)
+	}
+	return nil
+}
+
+// evalReference interprets the same program natively over big.Int mod m,
+// returning an error if the reference evaluation hits a constraint violation
+// (e.g. DivUnchecked/Inverse by zero), which the solver is expected to mirror.
+func evalReference(prog fuzzProgram, inputs []*big.Int, m *big.Int) ([]*big.Int, error) {
+	vals := make([]*big.Int, len(inputs))
+	copy(vals, inputs)
+	clampIdx := func(i int) int {
+		if len(vals) == 0 {
+			return 0
+		}
+		i %= len(vals)
+		if i < 0 {
+			i += len(vals)
+		}
+		return i
+	}
+	reduce := func(x *big.Int) *big.Int { return new(big.Int).Mod(x, m) }
+	isZero := func(x *big.Int) *big.Int {
+		if x.Sign() == 0 {
+			return big.NewInt(1)
+		}
+		return big.NewInt(0)
+	}
+	for _, op := range prog.Ops {
+		a := vals[clampIdx(op.A)]
+		b := vals[clampIdx(op.B)]
+		var res *big.Int
+		switch op.Kind % numOpKinds {
+		case opAdd:
+			res = reduce(new(big.Int).Add(a, b))
+		case opSub:
+			res = reduce(new(big.Int).Sub(a, b))
+		case opMul:
+			res = reduce(new(big.Int).Mul(a, b))
+		case opMulAcc:
+			c := vals[clampIdx(op.C)]
+			res = reduce(new(big.Int).Add(c, new(big.Int).Mul(a, b)))
+		case opDivUnchecked, opInverse:
+			if b.Sign() == 0 && op.Kind%numOpKinds == opDivUnchecked {
+				return nil, fmt.Errorf("division by zero")
+			}
+			if op.Kind%numOpKinds == opInverse && a.Sign() == 0 {
+				return nil, fmt.Errorf("inverse of zero")
+			}
+			if op.Kind%numOpKinds == opInverse {
+				res = reduce(new(big.Int).ModInverse(a, m))
+			} else {
+				res = reduce(new(big.Int).Mul(a, new(big.Int).ModInverse(b, m)))
+			}
+		case opXor:
+			res = reduce(new(big.Int).Xor(isZero(a), isZero(b)))
+		case opOr:
+			res = reduce(new(big.Int).Or(isZero(a), isZero(b)))
+		case opAnd:
+			res = reduce(new(big.Int).And(isZero(a), isZero(b)))
+		case opSelect:
+			if isZero(vals[clampIdx(op.Cond)]).Sign() != 0 {
+				res = a
+			} else {
+				res = b
+			}
+		case opLookup2:
+			s0 := isZero(vals[clampIdx(op.Cond)]).Sign() != 0
+			s1 := isZero(a).Sign() != 0
+			switch {
+			case !s0 && !s1:
+				res = a
+			case s0 && !s1:
+				res = b
+			case !s0 && s1:
+				res = a
+			default:
+				res = b
+			}
+		case opIsZero:
+			res = isZero(a)
+		case opCmp:
+			res = reduce(big.NewInt(int64(a.Cmp(b))))
+		case opEvaluatePlonkExpression, opAddPlonkConstraint:
+			// qL.a + qR.b + qM.ab + qC - o == 0, so o = qL.a + qR.b + qM.ab + qC.
+			qL := big.NewInt(int64(op.QL))
+			qR := big.NewInt(int64(op.QR))
+			qM := big.NewInt(int64(op.QM))
+			qC := big.NewInt(int64(op.QC))
+			o := new(big.Int).Mul(qL, a)
+			o.Add(o, new(big.Int).Mul(qR, b))
+			o.Add(o, new(big.Int).Mul(qM, new(big.Int).Mul(a, b)))
+			o.Add(o, qC)
+			res = reduce(o)
+		case opCommit:
+			// opCommit's output is a commitment with no big.Int reference.
+			res = a
+		}
+		vals = append(vals, res)
+	}
+	return vals, nil
+}
+
+func randomProgram(rng *rand.Rand, nbInputs, nbOps int) fuzzProgram {
+	prog := fuzzProgram{NbInputs: nbInputs, Ops: make([]fuzzOp, nbOps)}
+	for i := range prog.Ops {
+		prog.Ops[i] = fuzzOp{
+			Kind: opKind(rng.Intn(int(numOpKinds))),
+			A:    rng.Intn(nbInputs + i + 1),
+			B:    rng.Intn(nbInputs + i + 1),
+			C:    rng.Intn(nbInputs + i + 1),
+			Cond: rng.Intn(nbInputs + i + 1),
+			QL:   rng.Intn(5) - 2,
+			QR:   rng.Intn(5) - 2,
+			QM:   rng.Intn(5) - 2,
+			QC:   rng.Intn(5) - 2,
+		}
+	}
+	return prog
+}
+
+// shrink greedily removes trailing ops, then individual ops from anywhere in
+// the program, while the program still reproduces a failure, so a 500-gate
+// counter-example collapses to a minimal one. Operand indices and
+// coefficients are left as they are.
+func shrink(prog fuzzProgram, m *big.Int, stillFails func(fuzzProgram) bool) fuzzProgram {
+	for len(prog.Ops) > 0 {
+		candidate := fuzzProgram{NbInputs: prog.NbInputs, Ops: prog.Ops[:len(prog.Ops)-1]}
+		if stillFails(candidate) {
+			prog = candidate
+			continue
+		}
+		break
+	}
+	// try removing individual ops from the middle
+	changed := true
+	for changed {
+		changed = false
+		for i := range prog.Ops {
+			candidate := fuzzProgram{NbInputs: prog.NbInputs}
+			candidate.Ops = append(candidate.Ops, prog.Ops[:i]...)
+			candidate.Ops = append(candidate.Ops, prog.Ops[i+1:]...)
+			if stillFails(candidate) {
+				prog = candidate
+				changed = true
+				break
+			}
+		}
+	}
+	return prog
+}
+
+const corpusDir = "testdata/corpus"
+
+// corpusPath, loadCorpus and saveToCorpus are keyed by label rather than
+// ecc.ID so the same persisted-regression mechanism covers tinyfield (used
+// by TestFuzzBuilderTiny), which has no ecc.ID of its own, alongside the
+// real curves in TestFuzzBuilder.
+func corpusPath(label string, idx int) string {
+	return filepath.Join(corpusDir, fmt.Sprintf("%s-%d.json", label, idx))
+}
+
+func loadCorpus(label string) []fuzzProgram {
+	entries, err := os.ReadDir(corpusDir)
+	if err != nil {
+		return nil
+	}
+	var progs []fuzzProgram
+	prefix := label + "-"
+	for _, e := range entries {
+		if e.IsDir() || !hasPrefix(e.Name(), prefix) {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(corpusDir, e.Name()))
+		if err != nil {
+			continue
+		}
+		var p fuzzProgram
+		if json.Unmarshal(b, &p) == nil {
+			progs = append(progs, p)
+		}
+	}
+	return progs
+}
+
+func hasPrefix(s, prefix string) bool {
+	return len(s) >= len(prefix) && s[:len(prefix)] == prefix
+}
+
+func saveToCorpus(label string, prog fuzzProgram) {
+	_ = os.MkdirAll(corpusDir, 0o755)
+	for i := 0; ; i++ {
+		p := corpusPath(label, i)
+		if _, err := os.Stat(p); os.IsNotExist(err) {
+			b, _ := json.MarshalIndent(prog, "", "  ")
+			_ = os.WriteFile(p, b, 0o644)
+			return
+		}
+	}
+}
+
+// runProgram compiles prog with this package's builder, computes the
+// reference witness, and checks the solver's result matches (or that both
+// fail, for programs hitting a constraint violation such as division by
+// zero). m is the scalar field the program is evaluated and solved over;
+// callers pass curve.ScalarField() for a real curve or tinyfield.Modulus()
+// for the small-field sweep.
+func runProgram(t *testing.T, m *big.Int, prog fuzzProgram, inputs []*big.Int) bool {
+	t.Helper()
+	refVals, refErr := evalReference(prog, inputs, m)
+
+	// Only wire Expected when the reference evaluation actually produced
+	// output values; on a reference constraint violation (e.g. division by
+	// zero) there is nothing to assert and the solver is expected to fail
+	// on its own.
+	var expectedLen int
+	if refErr == nil {
+		expectedLen = len(prog.Ops)
+	}
+
+	assignment := &fuzzCircuit{Inputs: make([]frontend.Variable, prog.NbInputs), Expected: make([]frontend.Variable, expectedLen)}
+	witness := &fuzzCircuit{Inputs: make([]frontend.Variable, prog.NbInputs), Expected: make([]frontend.Variable, expectedLen), prog: prog}
+	assignment.prog = prog
+	for i, v := range inputs {
+		witness.Inputs[i] = v
+	}
+	if refErr == nil {
+		for i, v := range refVals[prog.NbInputs:] {
+			witness.Expected[i] = v
+		}
+	}
+
+	var solveErr error
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				solveErr = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		ccs, err := frontend.Compile(m, NewBuilder, assignment)
+		if err != nil {
+			solveErr = fmt.Errorf("compile: %w", err)
+			return
+		}
+		w, err := frontend.NewWitness(witness, m)
+		if err != nil {
+			solveErr = fmt.Errorf("witness: %w", err)
+			return
+		}
+		_, solveErr = ccs.Solve(w)
+	}()
+
+	if refErr != nil {
+		return solveErr != nil
+	}
+	if solveErr != nil {
+		return false
+	}
+	return true
+}
+
+func TestFuzzBuilder(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping property-based fuzz test in -short mode")
+	}
+	curves := []ecc.ID{ecc.BN254, ecc.BLS12_381, ecc.BLS12_377, ecc.BW6_761, ecc.BLS24_315}
+	for _, curve := range curves {
+		curve := curve
+		t.Run(curve.String(), func(t *testing.T) {
+			rng := rand.New(rand.NewSource(1))
+
+			// replay the persisted corpus first: these are permanent
+			// regression tests for previously found bugs.
+			label := curve.String()
+			for _, prog := range loadCorpus(label) {
+				inputs := make([]*big.Int, prog.NbInputs)
+				for i := range inputs {
+					inputs[i] = big.NewInt(int64(i + 1))
+				}
+				if ok := runProgram(t, curve.ScalarField(), prog, inputs); !ok {
+					t.Errorf("corpus regression program fails again for %s", curve)
+				}
+			}
+
+			for trial := 0; trial < 25; trial++ {
+				nbInputs := 2 + rng.Intn(4)
+				prog := randomProgram(rng, nbInputs, 10+rng.Intn(20))
+				inputs := make([]*big.Int, nbInputs)
+				for i := range inputs {
+					v, _ := randBigInt(rng, curve.ScalarField())
+					inputs[i] = v
+				}
+				if ok := runProgram(t, curve.ScalarField(), prog, inputs); !ok {
+					minimal := shrink(prog, curve.ScalarField(), func(p fuzzProgram) bool {
+						return !runProgram(t, curve.ScalarField(), p, inputs)
+					})
+					saveToCorpus(label, minimal)
+					t.Fatalf("found minimal counter-example for %s, persisted to corpus: %+v", curve, minimal)
+				}
+			}
+		})
+	}
+}
+
+func TestFuzzBuilderTiny(t *testing.T) {
+	// also cover a small field, where wrap-around behavior differs from the
+	// large scalar fields above.
+	const label = "tinyfield"
+	m := tinyfield.Modulus()
+	rng := rand.New(rand.NewSource(2))
+
+	// replay the persisted corpus first: these are permanent regression
+	// tests for previously found bugs.
+	for _, prog := range loadCorpus(label) {
+		inputs := make([]*big.Int, prog.NbInputs)
+		for i := range inputs {
+			inputs[i] = big.NewInt(int64(i + 1))
+		}
+		if ok := runProgram(t, m, prog, inputs); !ok {
+			t.Errorf("corpus regression program fails again for %s", label)
+		}
+	}
+
+	for trial := 0; trial < 10; trial++ {
+		nbInputs := 2 + rng.Intn(3)
+		prog := randomProgram(rng, nbInputs, 5+rng.Intn(10))
+		inputs := make([]*big.Int, nbInputs)
+		for i := range inputs {
+			v, _ := randBigInt(rng, m)
+			inputs[i] = v
+		}
+		if ok := runProgram(t, m, prog, inputs); !ok {
+			minimal := shrink(prog, m, func(p fuzzProgram) bool {
+				return !runProgram(t, m, p, inputs)
+			})
+			saveToCorpus(label, minimal)
+			t.Fatalf("found minimal counter-example for %s, persisted to corpus: %+v", label, minimal)
+		}
+	}
+}
+
+func randBigInt(rng *rand.Rand, m *big.Int) (*big.Int, error) {
+	n := new(big.Int).Rand(rng, m)
+	return n, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package scs
+
+import (
+	"sort"
+
+	"github.com/consensys/gnark/constraint"
+)
+
+// scheduleConstraints reorders builder.st's emitted sparseR1C[E] stream to
+// improve prover locality and hint-solving parallelism, analogous to a
+// list-scheduling pass in a compiler backend.
+//
+// It builds a DAG whose nodes are constraints and whose edges are
+// read-after-write dependencies through wire IDs: a constraint's xa/xb
+// operands are reads, its xc is the write (the newly introduced wire, as
+// emitted by newInternalVariable throughout this package). Constraints
+// carrying a commitment tag are additionally chained to the previous
+// commitment-tagged constraint, so COMMITTED wires stay sequentially ordered
+// relative to one another exactly as before scheduling.
+//
+// The schedule itself is a standard ready-list topological sort where, among
+// the constraints with satisfied dependencies, the one touching the lowest
+// wire ID is picked next; this groups constraints over overlapping wire
+// windows together, which is the locality property list-scheduling passes in
+// compiler backends exploit for cache behavior. Public input ordering and
+// commitment indices are untouched by construction, since neither is a
+// function of constraint order.
+func scheduleConstraints[E constraint.Element](cs []sparseR1C[E]) ([]sparseR1C[E], [][]int) {
+	n := len(cs)
+	if n == 0 {
+		return cs, nil
+	}
+
+	// writer[v] is the index of the constraint that introduces wire v, if any.
+	writer := make(map[int]int, n)
+	for i, c := range cs {
+		writer[c.xc] = i
+	}
+
+	deps := make([][]int, n)
+	lastCommitment := -1
+	for i, c := range cs {
+		seen := make(map[int]bool, 2)
+		addDep := func(wire int) {
+			if w, ok := writer[wire]; ok && w != i && !seen[w] {
+				deps[i] = append(deps[i], w)
+				seen[w] = true
+			}
+		}
+		addDep(c.xa)
+		addDep(c.xb)
+
+		if c.commitment == constraint.COMMITTED || c.commitment == constraint.COMMITMENT {
+			if lastCommitment >= 0 && !seen[lastCommitment] {
+				deps[i] = append(deps[i], lastCommitment)
+			}
+			lastCommitment = i
+		}
+	}
+
+	indegree := make([]int, n)
+	children := make([][]int, n)
+	for i, ds := range deps {
+		indegree[i] = len(ds)
+		for _, d := range ds {
+			children[d] = append(children[d], i)
+		}
+	}
+
+	minWire := func(c sparseR1C[E]) int {
+		m := c.xc
+		if c.xa < m {
+			m = c.xa
+		}
+		if c.xb < m {
+			m = c.xb
+		}
+		return m
+	}
+
+	ready := make([]int, 0, n)
+	for i := range cs {
+		if indegree[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	order := make([]int, 0, n)
+	level := make([]int, n)
+	var levels [][]int
+	for len(order) < n && len(ready) > 0 {
+		sort.Slice(ready, func(a, b int) bool {
+			wa, wb := minWire(cs[ready[a]]), minWire(cs[ready[b]])
+			if wa != wb {
+				return wa < wb
+			}
+			return ready[a] < ready[b]
+		})
+
+		next := ready[0]
+		ready = ready[1:]
+		order = append(order, next)
+		newIdx := len(order) - 1
+
+		lvl := 0
+		for _, d := range deps[next] {
+			if level[d]+1 > lvl {
+				lvl = level[d] + 1
+			}
+		}
+		level[next] = lvl
+		for len(levels) <= lvl {
+			levels = append(levels, nil)
+		}
+		levels[lvl] = append(levels[lvl], newIdx)
+
+		for _, ch := range children[next] {
+			indegree[ch]--
+			if indegree[ch] == 0 {
+				ready = append(ready, ch)
+			}
+		}
+	}
+
+	if len(order) != n {
+		// dependency cycle or unreachable constraint: something is wrong
+		// with the DAG construction above, keep the original order rather
+		// than silently dropping constraints.
+		return cs, nil
+	}
+
+	scheduled := make([]sparseR1C[E], n)
+	for newIdx, oldIdx := range order {
+		scheduled[newIdx] = cs[oldIdx]
+	}
+	return scheduled, levels
+}
+
+// ScheduleConstraints is the exported entry point for the pass: given the
+// constraints accumulated by a builder in emission order, it returns them
+// list-scheduled for prover locality, along with the independent-chain
+// partition (levels[i] holds the indices, in the *returned* order, of every
+// constraint at dependency depth i) that a parallel witness solver can use to
+// run a level at a time across worker goroutines.
+//
+// It is not wired into Compile: there is no frontend.CompileOption that
+// calls it yet, so nothing in this module invokes it today. It is exported
+// so that a future Compile integration (or a caller post-processing a
+// builder's constraint stream directly) can opt into it without needing
+// another exported copy of scheduleConstraints.
+func ScheduleConstraints[E constraint.Element](cs []sparseR1C[E]) (scheduled []sparseR1C[E], levels [][]int) {
+	return scheduleConstraints(cs)
+}
@@ -0,0 +1,86 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package scs
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark/constraint"
+)
+
+func TestScheduleConstraintsPreservesDependencies(t *testing.T) {
+	// c0: xc=2 from xa=0, xb=1
+	// c1: xc=3 from xa=2, xb=1 (depends on c0)
+	// c2: xc=4 from xa=0, xb=0 (independent)
+	cs := []sparseR1C[constraint.U64]{
+		{xa: 0, xb: 1, xc: 2},
+		{xa: 2, xb: 1, xc: 3},
+		{xa: 0, xb: 0, xc: 4},
+	}
+
+	scheduled, levels := ScheduleConstraints(cs)
+	if len(scheduled) != len(cs) {
+		t.Fatalf("expected %d constraints, got %d", len(cs), len(scheduled))
+	}
+
+	pos := make(map[int]int, len(scheduled))
+	for i, c := range scheduled {
+		pos[c.xc] = i
+	}
+	if pos[2] >= pos[3] {
+		t.Fatalf("constraint writing wire 3 (reads wire 2) scheduled before its dependency: pos[2]=%d pos[3]=%d", pos[2], pos[3])
+	}
+
+	if len(levels) == 0 {
+		t.Fatal("expected at least one dependency level")
+	}
+	// c2 is independent of the c0->c1 chain, so it must share a level with c0.
+	foundIndependent := false
+	for _, lvl := range levels[0] {
+		if scheduled[lvl].xc == 4 {
+			foundIndependent = true
+		}
+	}
+	if !foundIndependent {
+		t.Fatal("expected the independent constraint to be scheduled at depth 0")
+	}
+}
+
+func TestScheduleConstraintsLevelsIndexIntoScheduled(t *testing.T) {
+	// c0: xc=2 from xa=0, xb=1
+	// c1: xc=3 from xa=2, xb=1 (depends on c0)
+	// c2: xc=4 from xa=0, xb=0 (independent)
+	cs := []sparseR1C[constraint.U64]{
+		{xa: 0, xb: 1, xc: 2},
+		{xa: 2, xb: 1, xc: 3},
+		{xa: 0, xb: 0, xc: 4},
+	}
+
+	scheduled, levels := ScheduleConstraints(cs)
+
+	for _, lvl := range levels {
+		for _, idx := range lvl {
+			if idx < 0 || idx >= len(scheduled) {
+				t.Fatalf("levels entry %d is not a valid index into scheduled (len %d)", idx, len(scheduled))
+			}
+		}
+	}
+
+	// every entry of levels must be the position of that constraint in
+	// scheduled, not its position in the original cs slice.
+	for lvl, indices := range levels {
+		for _, idx := range indices {
+			if level := scheduled[idx]; level.xc == 3 && lvl == 0 {
+				t.Fatalf("constraint writing wire 3 must not be at depth 0, it depends on the constraint writing wire 2")
+			}
+		}
+	}
+}
+
+func TestScheduleConstraintsEmpty(t *testing.T) {
+	scheduled, levels := ScheduleConstraints[constraint.U64](nil)
+	if len(scheduled) != 0 || levels != nil {
+		t.Fatal("expected scheduling an empty stream to be a no-op")
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package gkr
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark/internal/small_rational"
+)
+
+func TestFieldHashAdapterSize(t *testing.T) {
+	h := newFieldHashAdapter(sha256.New())
+	if got := h.Size(); got != small_rational.Bytes {
+		t.Fatalf("expected Size() == small_rational.Bytes (%d), got %d", small_rational.Bytes, got)
+	}
+	sum := h.Sum(nil)
+	if len(sum) != small_rational.Bytes {
+		t.Fatalf("expected Sum to return %d bytes, got %d", small_rational.Bytes, len(sum))
+	}
+}
+
+func TestFieldHashAdapterDeterministic(t *testing.T) {
+	msg := make([]byte, 3*small_rational.Bytes+5)
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+
+	h1 := newFieldHashAdapter(sha256.New())
+	h1.Write(msg)
+	sum1 := h1.Sum(nil)
+
+	h2 := newFieldHashAdapter(sha256.New())
+	h2.Write(msg)
+	sum2 := h2.Sum(nil)
+
+	if string(sum1) != string(sum2) {
+		t.Fatalf("expected identical input to produce identical digests")
+	}
+
+	h2.Reset()
+	h2.Write(msg)
+	sum3 := h2.Sum(nil)
+	if string(sum1) != string(sum3) {
+		t.Fatalf("expected Reset to bring the adapter back to its initial state")
+	}
+}
+
+// TestFieldHashAdapterSumDoesNotMutate checks the hash.Hash.Sum contract
+// directly: calling Sum must not change what a subsequent Write/Sum sees,
+// even when Sum had to flush a partial, not-yet-Bytes-sized chunk to
+// produce its digest.
+func TestFieldHashAdapterSumDoesNotMutate(t *testing.T) {
+	msg := make([]byte, 3*small_rational.Bytes+5)
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+	a, b := msg[:2*small_rational.Bytes+3], msg[2*small_rational.Bytes+3:]
+
+	h1 := newFieldHashAdapter(sha256.New())
+	h1.Write(msg)
+	want := h1.Sum(nil)
+
+	h2 := newFieldHashAdapter(sha256.New())
+	h2.Write(a)
+	_ = h2.Sum(nil)
+	h2.Write(b)
+	got := h2.Sum(nil)
+
+	if string(want) != string(got) {
+		t.Fatalf("Sum mutated adapter state: Write(a+b);Sum() = %x, Write(a);Sum();Write(b);Sum() = %x", want, got)
+	}
+}
+
+func TestHashFromDescriptionRegistersSha256AndKeccak(t *testing.T) {
+	for _, name := range []string{"sha256", "keccak"} {
+		h, err := hashFromDescription(map[string]any{"type": name})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if got := h.Size(); got != small_rational.Bytes {
+			t.Fatalf("%s: expected Size() == small_rational.Bytes (%d), got %d", name, small_rational.Bytes, got)
+		}
+	}
+}
@@ -6,8 +6,13 @@
 package gkr
 
 import (
+	"crypto/sha256"
+	"encoding"
 	"fmt"
 	"hash"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
 
 	"github.com/consensys/gnark/internal/small_rational"
 	"github.com/consensys/gnark/internal/small_rational/polynomial"
@@ -21,6 +26,40 @@ func toElement(i int64) *small_rational.SmallRational {
 	return &res
 }
 
+// hashBuilders holds hash constructors registered via RegisterHash, keyed
+// by the "type" field a gkrtesting.HashDescription uses to select a real
+// Fiat-Shamir hash instead of the deterministic messageCounter fixture used
+// to unit-test GKR's sumcheck plumbing in isolation. Each builder receives
+// the full HashDescription as params, so a registered hash can read its own
+// extra fields (e.g. a seed) the same way "const" reads "val" below, and can
+// report a configuration error instead of panicking.
+//
+// No gkrtesting.HashDescription JSON fixture here drives sha256 or keccak
+// end-to-end yet: the fixtures live in internal/gkr/gkrtesting, and that
+// package isn't part of this tree, so there is nothing to add the "type":
+// "sha256" entry to. TestFieldHashAdapterSumDoesNotMutate and the other
+// tests in this package exercise sha256/keccak directly instead.
+var hashBuilders = map[string]func(params map[string]any) (hash.Hash, error){}
+
+func init() {
+	RegisterHash("sha256", func(params map[string]any) (hash.Hash, error) {
+		return newFieldHashAdapter(sha256.New()), nil
+	})
+	RegisterHash("keccak", func(params map[string]any) (hash.Hash, error) {
+		return newFieldHashAdapter(sha3.NewLegacyKeccak256()), nil
+	})
+}
+
+// RegisterHash makes a real hash constructor available to
+// hashFromDescription under name, so GKR test fixtures can drive an actual
+// transcript instead of the messageCounter placeholder. Algebraic hashes
+// such as MiMC or Poseidon2 are tied to a specific scalar field and are
+// registered by the curve-specific gkr packages that have one, rather than
+// here: small_rational is a toy field with no such hash defined over it.
+func RegisterHash(name string, builder func(params map[string]any) (hash.Hash, error)) {
+	hashBuilders[name] = builder
+}
+
 func hashFromDescription(d gkrtesting.HashDescription) (hash.Hash, error) {
 	if _type, ok := d["type"]; ok {
 		switch _type {
@@ -28,12 +67,127 @@ func hashFromDescription(d gkrtesting.HashDescription) (hash.Hash, error) {
 			startState := int64(d["val"].(float64))
 			return &messageCounter{startState: startState, step: 0, state: startState}, nil
 		default:
-			return nil, fmt.Errorf("unknown fake hash type \"%s\"", _type)
+			typeName, ok := _type.(string)
+			if !ok {
+				return nil, fmt.Errorf("hash description type must be a string")
+			}
+			builder, ok := hashBuilders[typeName]
+			if !ok {
+				return nil, fmt.Errorf("unknown hash type \"%s\"", typeName)
+			}
+			h, err := builder(d)
+			if err != nil {
+				return nil, fmt.Errorf("building hash %q: %w", typeName, err)
+			}
+			return h, nil
 		}
 	}
 	return nil, fmt.Errorf("hash description missing type")
 }
 
+// fieldHashAdapter wraps a byte-oriented hash.Hash (sha256, keccak, ...) so
+// it can stand in for an algebraic transcript hash over small_rational:
+// every other hash this registry serves -- messageCounter included -- has
+// Size() == small_rational.Bytes and consumes/produces field elements, not
+// raw bytes, so a caller driving a Fiat-Shamir transcript can swap between
+// them without caring which is underneath.
+//
+// Write buffers its input and, every time a full small_rational.Bytes-sized
+// chunk is available, reduces it into a field element and feeds that
+// element's canonical bytes to the wrapped hash, rather than the raw chunk.
+// Sum finalizes the wrapped hash (after flushing any partial last chunk the
+// same way) and reduces its digest down to exactly one field element,
+// returning that element's bytes, so Size() == small_rational.Bytes holds
+// for the adapter regardless of the wrapped hash's native digest size.
+type fieldHashAdapter struct {
+	h   hash.Hash
+	buf []byte
+}
+
+func newFieldHashAdapter(h hash.Hash) *fieldHashAdapter {
+	return &fieldHashAdapter{h: h}
+}
+
+// reduceBytes interprets p as a big-endian integer and reduces it to a field
+// element, returning that element's canonical small_rational.Bytes-sized
+// representation.
+func reduceBytes(p []byte) ([small_rational.Bytes]byte, error) {
+	var e small_rational.SmallRational
+	if _, err := e.SetInterface(new(big.Int).SetBytes(p)); err != nil {
+		return [small_rational.Bytes]byte{}, err
+	}
+	return e.Bytes(), nil
+}
+
+func (a *fieldHashAdapter) Write(p []byte) (n int, err error) {
+	n = len(p)
+	a.buf = append(a.buf, p...)
+	for len(a.buf) >= small_rational.Bytes {
+		reduced, err := reduceBytes(a.buf[:small_rational.Bytes])
+		if err != nil {
+			return n, err
+		}
+		if _, err := a.h.Write(reduced[:]); err != nil {
+			return n, err
+		}
+		a.buf = a.buf[small_rational.Bytes:]
+	}
+	return n, nil
+}
+
+func (a *fieldHashAdapter) Sum(b []byte) []byte {
+	// hash.Hash.Sum must not mutate the receiver: a.h is saved via
+	// encoding.BinaryMarshaler (which both crypto/sha256's and
+	// golang.org/x/crypto/sha3's concrete hash types implement) before any
+	// partial chunk is flushed into it, then restored from that snapshot
+	// once the digest is taken, so a.h and a.buf are exactly as a caller
+	// left them. Without this, Write(x); Sum(); Write(y); Sum() would see
+	// a different digest than Write(x); Sum(); then Write(y) continuing
+	// from where the first Write left off.
+	var restore []byte
+	if len(a.buf) > 0 {
+		marshaler, ok := a.h.(encoding.BinaryMarshaler)
+		if !ok {
+			// neither sha256 nor sha3's Keccak256 hits this path; kept as
+			// a guard rather than a panic for a future wrapped hash that
+			// doesn't support snapshotting.
+			return b
+		}
+		state, err := marshaler.MarshalBinary()
+		if err != nil {
+			return b
+		}
+		restore = state
+		if reduced, err := reduceBytes(a.buf); err == nil {
+			_, _ = a.h.Write(reduced[:])
+		}
+	}
+	digest := a.h.Sum(nil)
+	if restore != nil {
+		_ = a.h.(encoding.BinaryUnmarshaler).UnmarshalBinary(restore)
+	}
+	reduced, err := reduceBytes(digest)
+	if err != nil {
+		// reduceBytes only fails if SetInterface rejects a *big.Int, which
+		// it never does; kept as a guard rather than a panic.
+		return b
+	}
+	return append(b, reduced[:]...)
+}
+
+func (a *fieldHashAdapter) Reset() {
+	a.h.Reset()
+	a.buf = nil
+}
+
+func (a *fieldHashAdapter) Size() int {
+	return small_rational.Bytes
+}
+
+func (a *fieldHashAdapter) BlockSize() int {
+	return small_rational.Bytes
+}
+
 type messageCounter struct {
 	startState int64
 	state      int64
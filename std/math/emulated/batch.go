@@ -0,0 +1,128 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package emulated
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// maxBatchOverflow bounds how many overflow bits a Batch lets its pending
+// terms accumulate before folding them down with an intermediate Reduce.
+// Past this point the limbs risk growing past what the circuit's native
+// field can represent without wrapping, the same failure mode ComputationCircuit's
+// noReduce flag is there to probe.
+const maxBatchOverflow = 250
+
+// Batch lets a circuit enqueue a sequence of Add/Sub/Mul/MulConst operations
+// on Element[T] and materialize the result with Commit, paying for a single
+// amortized Reduce (and its accompanying range check) instead of one per
+// intermediate operation. It is built directly out of MulNoReduce and Sum:
+// every enqueued term is added to a pending list with Sum only ever called
+// once, at Commit, unless the accumulated overflow bound would otherwise
+// exceed the native field's capacity, in which case the batch folds early.
+type Batch[T FieldParams] struct {
+	f     *Field[T]
+	terms []*Element[T]
+
+	reductions   int
+	peakOverflow uint
+}
+
+// BatchStats reports how a Batch's pending operations were materialized,
+// so a circuit can check that batching is actually amortizing reductions
+// rather than silently falling back to one per operation.
+type BatchStats struct {
+	// Reductions is the total number of Reduce calls the batch performed,
+	// including any early folds triggered by overflow and the final one in
+	// Commit.
+	Reductions int
+	// PeakOverflow is the highest overflow, in bits, any term reached before
+	// being folded or committed.
+	PeakOverflow uint
+}
+
+// Batch starts a new deferred-reduction batch over f.
+func (f *Field[T]) Batch() *Batch[T] {
+	return &Batch[T]{f: f}
+}
+
+// Add enqueues +a.
+func (b *Batch[T]) Add(a *Element[T]) *Batch[T] {
+	b.enqueue(a)
+	return b
+}
+
+// Sub enqueues -a.
+func (b *Batch[T]) Sub(a *Element[T]) *Batch[T] {
+	b.enqueue(b.f.Neg(a))
+	return b
+}
+
+// Mul enqueues +a*c, computed with MulNoReduce so the multiplication itself
+// doesn't force a reduction.
+func (b *Batch[T]) Mul(a, c *Element[T]) *Batch[T] {
+	b.enqueue(b.f.MulNoReduce(a, c))
+	return b
+}
+
+// MulConst enqueues +a*c for a constant c.
+func (b *Batch[T]) MulConst(a *Element[T], c *big.Int) *Batch[T] {
+	b.enqueue(b.f.MulConst(a, c))
+	return b
+}
+
+// Sum enqueues the sum of as as a single term.
+func (b *Batch[T]) Sum(as ...*Element[T]) *Batch[T] {
+	b.enqueue(b.f.Sum(as...))
+	return b
+}
+
+func (b *Batch[T]) enqueue(e *Element[T]) {
+	if e.overflow > b.peakOverflow {
+		b.peakOverflow = e.overflow
+	}
+	if e.overflow > maxBatchOverflow {
+		e = b.f.Reduce(e)
+		b.reductions++
+	}
+	b.terms = append(b.terms, e)
+
+	if b.overflowBound() > maxBatchOverflow {
+		folded := b.f.Reduce(b.f.Sum(b.terms...))
+		b.reductions++
+		b.terms = []*Element[T]{folded}
+	}
+}
+
+// overflowBound estimates the overflow a Sum of the pending terms would
+// carry: each addition can grow the overflow by at most one bit, so summing
+// n terms grows it by log2(n) atop the largest individual term.
+func (b *Batch[T]) overflowBound() uint {
+	var maxOverflow uint
+	for _, t := range b.terms {
+		if t.overflow > maxOverflow {
+			maxOverflow = t.overflow
+		}
+	}
+	return maxOverflow + uint(bits.Len(uint(len(b.terms))))
+}
+
+// Commit materializes every enqueued operation into a single reduced
+// Element[T], via one Sum and one Reduce regardless of how many operations
+// were enqueued (barring any overflow-driven folds enqueue performed along
+// the way to stay within the native field's capacity).
+func (b *Batch[T]) Commit() *Element[T] {
+	if len(b.terms) == 0 {
+		return b.f.Zero()
+	}
+	res := b.f.Reduce(b.f.Sum(b.terms...))
+	b.reductions++
+	return res
+}
+
+// Stats reports this batch's BatchStats so far.
+func (b *Batch[T]) Stats() BatchStats {
+	return BatchStats{Reductions: b.reductions, PeakOverflow: b.peakOverflow}
+}
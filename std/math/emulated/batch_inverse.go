@@ -0,0 +1,44 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package emulated
+
+// BatchInverse returns the element-wise inverses of as, computed with a
+// single Inverse call via Montgomery's trick: a running product forward
+// over as, one Inverse of the total product, then a running product
+// backward to recover each individual inverse.
+func (f *Field[T]) BatchInverse(as []*Element[T]) []*Element[T] {
+	n := len(as)
+	if n == 0 {
+		return nil
+	}
+	prefix := make([]*Element[T], n)
+	prefix[0] = as[0]
+	for i := 1; i < n; i++ {
+		prefix[i] = f.Mul(prefix[i-1], as[i])
+	}
+
+	inv := f.Inverse(prefix[n-1])
+	res := make([]*Element[T], n)
+	for i := n - 1; i > 0; i-- {
+		res[i] = f.Mul(inv, prefix[i-1])
+		inv = f.Mul(inv, as[i])
+	}
+	res[0] = inv
+	return res
+}
+
+// BatchDiv returns as[i]/bs[i] element-wise, computed via BatchInverse so
+// the whole batch pays for a single modular inversion instead of one per
+// division.
+func (f *Field[T]) BatchDiv(as, bs []*Element[T]) []*Element[T] {
+	if len(as) != len(bs) {
+		panic("as and bs must have the same length")
+	}
+	invBs := f.BatchInverse(bs)
+	res := make([]*Element[T], len(as))
+	for i := range as {
+		res[i] = f.Mul(as[i], invBs[i])
+	}
+	return res
+}
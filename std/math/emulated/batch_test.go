@@ -0,0 +1,259 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package emulated
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type BatchSumCircuit[T FieldParams] struct {
+	Inputs   []Element[T]
+	Expected Element[T]
+}
+
+func (c *BatchSumCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	b := f.Batch()
+	for i := range c.Inputs {
+		b.Add(&c.Inputs[i])
+	}
+	res := b.Commit()
+	f.AssertIsEqual(res, &c.Expected)
+	if stats := b.Stats(); stats.Reductions != 1 {
+		return fmt.Errorf("expected a single amortized reduction, got %d", stats.Reductions)
+	}
+	return nil
+}
+
+func TestBatchSum(t *testing.T) {
+	testBatchSum[Goldilocks](t)
+	testBatchSum[Secp256k1Fp](t)
+	testBatchSum[BN254Fp](t)
+}
+
+func testBatchSum[T FieldParams](t *testing.T) {
+	var fp T
+	nbInputs := 1024
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		circuit := &BatchSumCircuit[T]{Inputs: make([]Element[T], nbInputs)}
+		inputs := make([]Element[T], nbInputs)
+		result := new(big.Int)
+		for i := range inputs {
+			val, _ := rand.Int(rand.Reader, fp.Modulus())
+			result.Add(result, val)
+			inputs[i] = ValueOf[T](val)
+		}
+		result.Mod(result, fp.Modulus())
+		witness := &BatchSumCircuit[T]{Inputs: inputs, Expected: ValueOf[T](result)}
+		assert.CheckCircuit(circuit, test.WithValidAssignment(witness))
+	}, testName[T]())
+}
+
+type BatchMixedOpsCircuit[T FieldParams] struct {
+	A, B, C  Element[T]
+	Const    *big.Int
+	Expected Element[T]
+}
+
+func (c *BatchMixedOpsCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	b := f.Batch()
+	b.Add(&c.A)
+	b.Sub(&c.B)
+	b.Mul(&c.A, &c.C)
+	b.MulConst(&c.B, c.Const)
+	b.Sum(&c.A, &c.B, &c.C)
+	res := b.Commit()
+	f.AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+// TestBatchMixedOps exercises Sub, Mul, MulConst and Sum alongside Add,
+// which TestBatchSum already covers on its own.
+func TestBatchMixedOps(t *testing.T) {
+	testBatchMixedOps[Goldilocks](t)
+	testBatchMixedOps[Secp256k1Fp](t)
+	testBatchMixedOps[BN254Fp](t)
+}
+
+func testBatchMixedOps[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		a, _ := rand.Int(rand.Reader, fp.Modulus())
+		bb, _ := rand.Int(rand.Reader, fp.Modulus())
+		cc, _ := rand.Int(rand.Reader, fp.Modulus())
+		k, _ := rand.Int(rand.Reader, fp.Modulus())
+
+		m := fp.Modulus()
+		expected := new(big.Int).Sub(a, bb)
+		expected.Add(expected, new(big.Int).Mul(a, cc))
+		expected.Add(expected, new(big.Int).Mul(bb, k))
+		expected.Add(expected, a)
+		expected.Add(expected, bb)
+		expected.Add(expected, cc)
+		expected.Mod(expected, m)
+
+		circuit := &BatchMixedOpsCircuit[T]{Const: k}
+		witness := &BatchMixedOpsCircuit[T]{
+			A: ValueOf[T](a), B: ValueOf[T](bb), C: ValueOf[T](cc), Const: k,
+			Expected: ValueOf[T](expected),
+		}
+		assert.CheckCircuit(circuit, test.WithValidAssignment(witness))
+	}, testName[T]())
+}
+
+// BatchOverflowCircuit feeds the batch a chain of repeated squarings built
+// with MulNoReduce outside the batch, so each enqueued term carries more
+// overflow than the last, to force enqueue's overflow-triggered early fold
+// (maxBatchOverflow) rather than the single amortized Reduce at Commit that
+// TestBatchSum's plain Adds never come close to needing.
+type BatchOverflowCircuit[T FieldParams] struct {
+	X        Element[T]
+	Expected Element[T]
+}
+
+func (c *BatchOverflowCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	b := f.Batch()
+	acc := &c.X
+	for i := 0; i < 8; i++ {
+		b.Add(acc)
+		acc = f.MulNoReduce(acc, acc)
+	}
+	res := b.Commit()
+	f.AssertIsEqual(res, &c.Expected)
+	if stats := b.Stats(); stats.Reductions <= 1 {
+		return fmt.Errorf("expected the batch to fold early under overflow pressure, got %d reductions", stats.Reductions)
+	}
+	return nil
+}
+
+func TestBatchOverflow(t *testing.T) {
+	testBatchOverflow[Goldilocks](t)
+	testBatchOverflow[Secp256k1Fp](t)
+	testBatchOverflow[BN254Fp](t)
+}
+
+func testBatchOverflow[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		m := fp.Modulus()
+		x, _ := rand.Int(rand.Reader, m)
+
+		expected := new(big.Int)
+		pow := new(big.Int).Set(x)
+		for i := 0; i < 8; i++ {
+			expected.Add(expected, pow)
+			pow.Mul(pow, pow)
+			pow.Mod(pow, m)
+		}
+		expected.Mod(expected, m)
+
+		witness := &BatchOverflowCircuit[T]{X: ValueOf[T](x), Expected: ValueOf[T](expected)}
+		assert.CheckCircuit(&BatchOverflowCircuit[T]{}, test.WithValidAssignment(witness))
+	}, testName[T]())
+}
+
+type BatchInverseCircuit[T FieldParams] struct {
+	Inputs   []Element[T]
+	Expected []Element[T]
+}
+
+func (c *BatchInverseCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	inputs := make([]*Element[T], len(c.Inputs))
+	for i := range inputs {
+		inputs[i] = &c.Inputs[i]
+	}
+	res := f.BatchInverse(inputs)
+	if len(res) != len(c.Expected) {
+		return fmt.Errorf("expected %d inverses, got %d", len(c.Expected), len(res))
+	}
+	for i := range res {
+		f.AssertIsEqual(res[i], &c.Expected[i])
+	}
+	return nil
+}
+
+func TestBatchInverse(t *testing.T) {
+	testBatchInverse[Goldilocks](t)
+	testBatchInverse[Secp256k1Fp](t)
+	testBatchInverse[BN254Fp](t)
+}
+
+func testBatchInverse[T FieldParams](t *testing.T) {
+	var fp T
+	nbInputs := 1024
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		circuit := &BatchInverseCircuit[T]{Inputs: make([]Element[T], nbInputs), Expected: make([]Element[T], nbInputs)}
+		inputs := make([]Element[T], nbInputs)
+		expected := make([]Element[T], nbInputs)
+		for i := range inputs {
+			val, _ := rand.Int(rand.Reader, fp.Modulus())
+			if val.Sign() == 0 {
+				val.SetInt64(1)
+			}
+			inputs[i] = ValueOf[T](val)
+			expected[i] = ValueOf[T](new(big.Int).ModInverse(val, fp.Modulus()))
+		}
+		witness := &BatchInverseCircuit[T]{Inputs: inputs, Expected: expected}
+		assert.CheckCircuit(circuit, test.WithValidAssignment(witness))
+	}, testName[T]())
+}
+
+func TestBatchInverseZero(t *testing.T) {
+	testBatchInverseZero[Goldilocks](t)
+	testBatchInverseZero[Secp256k1Fp](t)
+	testBatchInverseZero[BN254Fp](t)
+}
+
+// testBatchInverseZero checks that a zero among BatchInverse's inputs is a
+// circuit-unsatisfiability failure, not a silently wrong result: Montgomery's
+// trick divides by the running product of all inputs, which is zero as soon
+// as one of them is, so the shared inverse the batch factors out does not
+// exist.
+func testBatchInverseZero[T FieldParams](t *testing.T) {
+	var fp T
+	nbInputs := 8
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		inputs := make([]Element[T], nbInputs)
+		expected := make([]Element[T], nbInputs)
+		for i := range inputs {
+			val, _ := rand.Int(rand.Reader, fp.Modulus())
+			if val.Sign() == 0 {
+				val.SetInt64(1)
+			}
+			inputs[i] = ValueOf[T](val)
+			expected[i] = ValueOf[T](new(big.Int).ModInverse(val, fp.Modulus()))
+		}
+		inputs[nbInputs/2] = ValueOf[T](big.NewInt(0))
+
+		circuit := &BatchInverseCircuit[T]{Inputs: make([]Element[T], nbInputs), Expected: make([]Element[T], nbInputs)}
+		witness := &BatchInverseCircuit[T]{Inputs: inputs, Expected: expected}
+		assert.CheckCircuit(circuit, test.WithInvalidAssignment(witness))
+	}, testName[T]())
+}
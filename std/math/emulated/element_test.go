@@ -978,6 +978,68 @@ func testSqrt[T FieldParams](t *testing.T) {
 	}, testName[T]())
 }
 
+type IsSquareCircuit[T FieldParams] struct {
+	X           Element[T]
+	ExpIsSquare frontend.Variable
+	ExpLegendre frontend.Variable
+}
+
+func (c *IsSquareCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(f.IsSquare(&c.X), c.ExpIsSquare)
+	api.AssertIsEqual(f.Legendre(&c.X), c.ExpLegendre)
+	return nil
+}
+
+// TestIsSquare exercises both branches sqrtAndLegendre's non-residue path
+// takes: a quadratic residue (the branch TestSqrt already covers via Sqrt)
+// and a genuine non-residue, which only IsSquare/Legendre observe since
+// Sqrt's return value is unspecified when a has no square root.
+func TestIsSquare(t *testing.T) {
+	testIsSquare[Goldilocks](t)
+	testIsSquare[Secp256k1Fp](t)
+	testIsSquare[BN254Fp](t)
+}
+
+func testIsSquare[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		p := fp.Modulus()
+		var residue, nonResidue *big.Int
+		exp := new(big.Int)
+		for {
+			X, _ := rand.Int(rand.Reader, p)
+			if X.Sign() == 0 {
+				continue
+			}
+			if residue == nil && exp.ModSqrt(X, p) != nil {
+				residue = X
+			}
+			if nonResidue == nil && exp.ModSqrt(X, p) == nil {
+				nonResidue = X
+			}
+			if residue != nil && nonResidue != nil {
+				break
+			}
+		}
+
+		circuit := IsSquareCircuit[T]{}
+		assert.CheckCircuit(&circuit, test.WithValidAssignment(&IsSquareCircuit[T]{
+			X: ValueOf[T](residue), ExpIsSquare: 1, ExpLegendre: 1,
+		}))
+		assert.CheckCircuit(&circuit, test.WithValidAssignment(&IsSquareCircuit[T]{
+			X: ValueOf[T](nonResidue), ExpIsSquare: 0, ExpLegendre: -1,
+		}))
+		assert.CheckCircuit(&circuit, test.WithValidAssignment(&IsSquareCircuit[T]{
+			X: ValueOf[T](0), ExpIsSquare: 1, ExpLegendre: 1,
+		}))
+	}, testName[T]())
+}
+
 type MulNoReduceCircuit[T FieldParams] struct {
 	A, B, C          Element[T]
 	expectedOverflow uint
@@ -1394,7 +1456,7 @@ func (c *PolyEvalNegativeCoefficient[T]) Define(api frontend.API) error {
 	}
 	// x - y
 	coefficients := []int{1, -1}
-	res := f.Eval([][]*Element[T]{{&c.Inputs[0]}, {&c.Inputs[1]}}, coefficients)
+	res := f.EvalSigned([][]*Element[T]{{&c.Inputs[0]}, {&c.Inputs[1]}}, coefficients)
 	f.AssertIsEqual(res, &c.Res)
 	return nil
 }
@@ -1406,10 +1468,8 @@ func TestPolyEvalNegativeCoefficient(t *testing.T) {
 }
 
 func testPolyEvalNegativeCoefficient[T FieldParams](t *testing.T) {
-	t.Skip("not implemented yet")
 	assert := test.NewAssert(t)
 	var fp T
-	fmt.Println("modulus", fp.Modulus())
 	var err error
 	const nbInputs = 2
 	inputs := make([]*big.Int, nbInputs)
@@ -1419,17 +1479,67 @@ func testPolyEvalNegativeCoefficient[T FieldParams](t *testing.T) {
 		assert.NoError(err)
 	}
 	for i := range inputs {
-		fmt.Println("input", i, inputs[i])
 		assignmentInput[i] = ValueOf[T](inputs[i])
 	}
 	expected := new(big.Int).Sub(inputs[0], inputs[1])
 	expected.Mod(expected, fp.Modulus())
-	fmt.Println("expected", expected)
 	assignment := &PolyEvalNegativeCoefficient[T]{Inputs: assignmentInput, Res: ValueOf[T](expected)}
 	err = test.IsSolved(&PolyEvalNegativeCoefficient[T]{Inputs: make([]Element[T], nbInputs)}, assignment, testCurve.ScalarField())
 	assert.NoError(err)
 }
 
+type EvalBigIntLargeCoeffCircuit[T FieldParams] struct {
+	Inputs   []Element[T]
+	Coeffs   []*big.Int
+	Expected Element[T]
+}
+
+func (c *EvalBigIntLargeCoeffCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	terms := make([][]*Element[T], len(c.Inputs))
+	for i := range terms {
+		terms[i] = []*Element[T]{&c.Inputs[i]}
+	}
+	res := f.EvalBigInt(terms, c.Coeffs)
+	f.AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+func TestEvalBigIntLargeCoefficient(t *testing.T) {
+	testEvalBigIntLargeCoefficient[Goldilocks](t)
+	testEvalBigIntLargeCoefficient[BN254Fr](t)
+	testEvalBigIntLargeCoefficient[emparams.Mod1e512](t)
+}
+
+func testEvalBigIntLargeCoefficient[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		// coefficients whose absolute value exceeds 2^BitsPerLimb, one of
+		// each sign, to exercise both the positive and negative buckets.
+		large := new(big.Int).Lsh(big.NewInt(1), fp.BitsPerLimb()+1)
+		coeffs := []*big.Int{large, new(big.Int).Neg(large)}
+		inputs := make([]*big.Int, len(coeffs))
+		assignmentInput := make([]Element[T], len(coeffs))
+		expected := new(big.Int)
+		for i := range inputs {
+			val, err := rand.Int(rand.Reader, fp.Modulus())
+			assert.NoError(err)
+			inputs[i] = val
+			assignmentInput[i] = ValueOf[T](val)
+			term := new(big.Int).Mul(val, coeffs[i])
+			expected.Add(expected, term)
+		}
+		expected.Mod(expected, fp.Modulus())
+		circuit := &EvalBigIntLargeCoeffCircuit[T]{Inputs: make([]Element[T], len(coeffs)), Coeffs: coeffs}
+		assignment := &EvalBigIntLargeCoeffCircuit[T]{Inputs: assignmentInput, Coeffs: coeffs, Expected: ValueOf[T](expected)}
+		assert.CheckCircuit(circuit, test.WithValidAssignment(assignment))
+	}, testName[T]())
+}
+
 type FastPathsCircuit[T FieldParams] struct {
 	Rand Element[T]
 	Zero Element[T]
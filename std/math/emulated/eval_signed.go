@@ -0,0 +1,55 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package emulated
+
+import "math/big"
+
+// EvalBigInt evaluates a weighted sum of products, like Eval, but allows
+// coefficients of arbitrary sign and magnitude. Each term's product is
+// computed once without reducing the intermediate multiplications, scaled
+// by the absolute value of its coefficient, and routed into a positive or
+// negative bucket depending on the coefficient's sign; the two buckets are
+// summed separately and subtracted once, with a single deferred reduction
+// at the end, instead of negating per-term with an explicit Sub.
+func (f *Field[T]) EvalBigInt(terms [][]*Element[T], coefficients []*big.Int) *Element[T] {
+	if len(terms) != len(coefficients) {
+		panic("terms and coefficients length mismatch")
+	}
+	var posTerms, negTerms []*Element[T]
+	for i, term := range terms {
+		if coefficients[i].Sign() == 0 {
+			continue
+		}
+		val := f.One()
+		for _, t := range term {
+			val = f.MulNoReduce(val, t)
+		}
+		scaled := f.MulConst(val, new(big.Int).Abs(coefficients[i]))
+		if coefficients[i].Sign() < 0 {
+			negTerms = append(negTerms, scaled)
+		} else {
+			posTerms = append(posTerms, scaled)
+		}
+	}
+	switch {
+	case len(posTerms) == 0 && len(negTerms) == 0:
+		return f.Zero()
+	case len(negTerms) == 0:
+		return f.Reduce(f.Sum(posTerms...))
+	case len(posTerms) == 0:
+		return f.Reduce(f.Neg(f.Sum(negTerms...)))
+	default:
+		return f.Reduce(f.Sub(f.Sum(posTerms...), f.Sum(negTerms...)))
+	}
+}
+
+// EvalSigned is Eval generalized to accept coefficients that may be
+// negative, via EvalBigInt.
+func (f *Field[T]) EvalSigned(terms [][]*Element[T], coefficients []int) *Element[T] {
+	bigCoefficients := make([]*big.Int, len(coefficients))
+	for i, c := range coefficients {
+		bigCoefficients[i] = big.NewInt(int64(c))
+	}
+	return f.EvalBigInt(terms, bigCoefficients)
+}
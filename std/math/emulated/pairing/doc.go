@@ -0,0 +1,22 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package pairing implements an in-circuit optimal-ate pairing on top of
+// the Fp12 tower built by math/emulated/tower, so that a circuit can verify
+// a pairing-based proof (e.g. a Groth16 proof) over an outer curve whose
+// scalar field differs from the pairing curve's base field.
+//
+// The Miller loop and final exponentiation are generic: everything specific
+// to a given pairing-friendly curve (the ate loop parameter, the sextic
+// twist's line-evaluation formulas, the final exponentiation's hard-part
+// exponent) is supplied by an implementation of Curve, analogous to how
+// tower.NonResidues supplies the non-residues the Fp2/Fp6/Fp12 towers are
+// built out of. This package does not itself ship BN254 or BLS12-381
+// implementations of Curve: the only Curve it defines is toyCurve in
+// toy_curve.go, an unexported, deliberately simplified implementation used
+// to exercise millerLoop/finalExponentiation in this package's own tests.
+// It performs real short-Weierstrass point arithmetic but a line evaluation
+// that multiplies a full Fp12 element rather than the sparse one a sextic
+// twist's D/M-type line would produce, so it is not a real curve's pairing
+// and should not be used for anything beyond testing this package.
+package pairing
@@ -0,0 +1,185 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package pairing
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/tower"
+)
+
+// G1Affine is an affine point on the pairing curve's base curve, over the
+// outer circuit's Element[T].
+type G1Affine[T emulated.FieldParams] struct {
+	X, Y *emulated.Element[T]
+}
+
+// G2Affine is an affine point on the pairing curve's twist, over Fp2.
+type G2Affine[T emulated.FieldParams] struct {
+	X, Y *tower.E2[T]
+}
+
+// LineEvaluation is a single Miller-loop line, evaluated at a fixed G1
+// point and expressed directly as an Fp12 element ready to multiply into
+// the Miller loop's accumulator. Computing it is the one step that differs
+// between a D-type twist (e.g. BN254's) and an M-type twist (e.g.
+// BLS12-381's), which is why it is a Curve responsibility rather than
+// something this package derives itself.
+type LineEvaluation[T emulated.FieldParams] = tower.E12[T]
+
+// Curve describes the per-curve constants and twist-specific arithmetic an
+// optimal-ate pairing needs on top of the generic Fp12 tower.
+type Curve[T emulated.FieldParams] interface {
+	tower.NonResidues[T]
+
+	// AteLoopNAF returns the non-adjacent-form digits of the ate loop
+	// parameter, least-significant digit first, each in {-1,0,1}, with the
+	// leading (most significant) digit omitted since the loop starts with
+	// the accumulator already initialized to the input points. millerLoop
+	// walks the slice from its last entry down to its first.
+	AteLoopNAF() []int8
+	// AteLoopNegative reports whether the loop parameter driving the Miller
+	// loop (e.g. BLS12-381's x) is negative, in which case the accumulator
+	// must be conjugated once the loop completes.
+	AteLoopNegative() bool
+	// FinalExpHardPart is the exponent of the hard part of the final
+	// exponentiation, applied after the easy part (p^6-1)(p^2+1).
+	FinalExpHardPart() *big.Int
+
+	// DoubleStep doubles T and returns the new point together with the
+	// tangent line at T, evaluated at P and embedded into Fp12.
+	DoubleStep(ft *tower.FieldTower[T], T *G2Affine[T], P *G1Affine[T]) (*G2Affine[T], *LineEvaluation[T])
+	// AddStep adds Q into T and returns the new point together with the
+	// line through T and Q, evaluated at P and embedded into Fp12.
+	AddStep(ft *tower.FieldTower[T], T, Q *G2Affine[T], P *G1Affine[T]) (*G2Affine[T], *LineEvaluation[T])
+}
+
+// Pairing implements the optimal-ate pairing over T's tower, parameterised
+// by a concrete Curve.
+type Pairing[T emulated.FieldParams] struct {
+	fp    *emulated.Field[T]
+	tower *tower.FieldTower[T]
+	curve Curve[T]
+}
+
+// New builds a Pairing for the curve described by curve.
+func New[T emulated.FieldParams](api frontend.API, curve Curve[T]) (*Pairing[T], error) {
+	fp, err := emulated.NewField[T](api)
+	if err != nil {
+		return nil, fmt.Errorf("new field: %w", err)
+	}
+	ft, err := tower.NewFieldTower[T](api, curve)
+	if err != nil {
+		return nil, fmt.Errorf("new field tower: %w", err)
+	}
+	return &Pairing[T]{fp: fp, tower: ft, curve: curve}, nil
+}
+
+func (p *Pairing[T]) negG2(a *G2Affine[T]) *G2Affine[T] {
+	return &G2Affine[T]{X: a.X, Y: p.tower.Fp2.Neg(a.Y)}
+}
+
+// millerLoop accumulates the Miller value of the pairs (P_i, Q_i) into a
+// single Fp12 element, scanning the ate loop parameter's NAF digits and
+// doubling T_i every step, additionally adding Q_i (or -Q_i) on non-zero
+// digits.
+func (p *Pairing[T]) millerLoop(P []*G1Affine[T], Q []*G2Affine[T]) (*tower.E12[T], error) {
+	if len(P) == 0 || len(P) != len(Q) {
+		return nil, fmt.Errorf("mismatched number of G1 and G2 points")
+	}
+	n := len(P)
+	Ts := make([]*G2Affine[T], n)
+	copy(Ts, Q)
+
+	res := p.tower.Fp12.One()
+	naf := p.curve.AteLoopNAF()
+	for i := len(naf) - 1; i >= 0; i-- {
+		res = p.tower.Fp12.Square(res)
+		for j := 0; j < n; j++ {
+			var line *LineEvaluation[T]
+			Ts[j], line = p.curve.DoubleStep(p.tower, Ts[j], P[j])
+			res = p.tower.Fp12.Mul(res, line)
+		}
+		if naf[i] != 0 {
+			Qj := Q
+			if naf[i] < 0 {
+				Qj = make([]*G2Affine[T], n)
+				for j := 0; j < n; j++ {
+					Qj[j] = p.negG2(Q[j])
+				}
+			}
+			for j := 0; j < n; j++ {
+				var line *LineEvaluation[T]
+				Ts[j], line = p.curve.AddStep(p.tower, Ts[j], Qj[j], P[j])
+				res = p.tower.Fp12.Mul(res, line)
+			}
+		}
+	}
+	if p.curve.AteLoopNegative() {
+		res = p.tower.Fp12.Conjugate(res)
+	}
+	return res, nil
+}
+
+// finalExponentiation raises f to (p^12-1)/r. The easy part (p^6-1)(p^2+1)
+// is computed exactly via Conjugate/Inverse/Frobenius; the hard part's
+// exponent is supplied per curve by Curve.FinalExpHardPart and applied with
+// a generic cyclotomic square-and-multiply rather than a hand-optimized,
+// curve-specific addition chain.
+func (p *Pairing[T]) finalExponentiation(f *tower.E12[T]) *tower.E12[T] {
+	fInv := p.tower.Fp12.Inverse(f)
+	f = p.tower.Fp12.Mul(p.tower.Fp12.Conjugate(f), fInv) // f^(p^6-1)
+
+	f2 := p.tower.Fp12.Frobenius(p.tower.Fp12.Frobenius(f)) // f^(p^2)
+	f = p.tower.Fp12.Mul(f2, f)                             // f^((p^6-1)(p^2+1))
+
+	return p.expHardPart(f)
+}
+
+func (p *Pairing[T]) expHardPart(f *tower.E12[T]) *tower.E12[T] {
+	e := p.curve.FinalExpHardPart()
+	res := p.tower.Fp12.One()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		res = p.tower.Fp12.CyclotomicSquare(res)
+		if e.Bit(i) == 1 {
+			res = p.tower.Fp12.Mul(res, f)
+		}
+	}
+	return res
+}
+
+// MillerLoopAndFinalExpUnchecked computes the product of pairings
+// e(P_i, Q_i) without asserting that the P_i, Q_i lie in their respective
+// prime-order subgroups; callers composing with their own in-circuit
+// subgroup checks should use this instead of Pair.
+func (p *Pairing[T]) MillerLoopAndFinalExpUnchecked(P []*G1Affine[T], Q []*G2Affine[T]) (*tower.E12[T], error) {
+	ml, err := p.millerLoop(P, Q)
+	if err != nil {
+		return nil, fmt.Errorf("miller loop: %w", err)
+	}
+	return p.finalExponentiation(ml), nil
+}
+
+// Pair computes the product of pairings e(P_i, Q_i). It currently matches
+// MillerLoopAndFinalExpUnchecked: this package does not yet implement
+// in-circuit G1/G2 subgroup membership checks, so callers passing points
+// from an untrusted source must still assert membership themselves before
+// calling Pair.
+func (p *Pairing[T]) Pair(P []*G1Affine[T], Q []*G2Affine[T]) (*tower.E12[T], error) {
+	return p.MillerLoopAndFinalExpUnchecked(P, Q)
+}
+
+// PairingCheck asserts that the product of pairings e(P_i, Q_i) equals 1,
+// the check used to verify a Groth16/PLONK proof in-circuit.
+func (p *Pairing[T]) PairingCheck(P []*G1Affine[T], Q []*G2Affine[T]) error {
+	res, err := p.Pair(P, Q)
+	if err != nil {
+		return err
+	}
+	p.tower.Fp12.AssertIsEqual(res, p.tower.Fp12.One())
+	return nil
+}
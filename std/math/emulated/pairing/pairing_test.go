@@ -0,0 +1,253 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package pairing
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/tower"
+	"github.com/consensys/gnark/test"
+)
+
+var modulus = func() *big.Int {
+	var fp emulated.BN254Fp
+	return fp.Modulus()
+}()
+
+func modReduce(x *big.Int) *big.Int {
+	return new(big.Int).Mod(x, modulus)
+}
+
+// refFp2 is an independent big.Int mirror of Fp[u]/(u²+1) (β=-1, the same
+// non-residue toyNonResidues uses), used to check toyCurve's DoubleStep and
+// AddStep point/line arithmetic without going through the in-circuit
+// Field2 those methods are built on.
+type refFp2 struct{ A0, A1 *big.Int }
+
+func randRefFp2() refFp2 {
+	a0, _ := rand.Int(rand.Reader, modulus)
+	a1, _ := rand.Int(rand.Reader, modulus)
+	return refFp2{a0, a1}
+}
+
+func (a refFp2) add(b refFp2) refFp2 {
+	return refFp2{modReduce(new(big.Int).Add(a.A0, b.A0)), modReduce(new(big.Int).Add(a.A1, b.A1))}
+}
+
+func (a refFp2) neg() refFp2 {
+	return refFp2{modReduce(new(big.Int).Neg(a.A0)), modReduce(new(big.Int).Neg(a.A1))}
+}
+
+func (a refFp2) sub(b refFp2) refFp2 { return a.add(b.neg()) }
+
+func (a refFp2) mul(b refFp2) refFp2 {
+	a0b0 := new(big.Int).Mul(a.A0, b.A0)
+	a1b1 := new(big.Int).Mul(a.A1, b.A1)
+	a0b1 := new(big.Int).Mul(a.A0, b.A1)
+	a1b0 := new(big.Int).Mul(a.A1, b.A0)
+	c0 := new(big.Int).Sub(a0b0, a1b1) // β = -1
+	c1 := new(big.Int).Add(a0b1, a1b0)
+	return refFp2{modReduce(c0), modReduce(c1)}
+}
+
+func (a refFp2) square() refFp2 { return a.mul(a) }
+
+func (a refFp2) inverse() refFp2 {
+	a0Sq := new(big.Int).Mul(a.A0, a.A0)
+	a1Sq := new(big.Int).Mul(a.A1, a.A1)
+	norm := modReduce(new(big.Int).Add(a0Sq, a1Sq))
+	normInv := new(big.Int).ModInverse(norm, modulus)
+	c0 := modReduce(new(big.Int).Mul(a.A0, normInv))
+	c1 := modReduce(new(big.Int).Mul(new(big.Int).Neg(a.A1), normInv))
+	return refFp2{c0, c1}
+}
+
+func (a refFp2) toE2() tower.E2[emulated.BN254Fp] {
+	a0 := emulated.ValueOf[emulated.BN254Fp](a.A0)
+	a1 := emulated.ValueOf[emulated.BN254Fp](a.A1)
+	return tower.E2[emulated.BN254Fp]{A0: &a0, A1: &a1}
+}
+
+func fromFp(x *big.Int) refFp2 { return refFp2{modReduce(x), big.NewInt(0)} }
+
+// refDouble mirrors toyCurve.DoubleStep: lambda = 3x²/2y, xr = lambda²-2x,
+// yr = lambda(x-xr)-y, line = py - (ty + lambda*(px-tx)).
+func refDouble(tx, ty, px, py refFp2) (xr, yr, l refFp2) {
+	xSq := tx.square()
+	threeXSq := xSq.add(xSq).add(xSq)
+	twoY := ty.add(ty)
+	lambda := threeXSq.mul(twoY.inverse())
+
+	l = py.sub(ty.add(lambda.mul(px.sub(tx))))
+	xr = lambda.square().sub(tx.add(tx))
+	yr = lambda.mul(tx.sub(xr)).sub(ty)
+	return xr, yr, l
+}
+
+// refAdd mirrors toyCurve.AddStep.
+func refAdd(tx, ty, qx, qy, px, py refFp2) (xr, yr, l refFp2) {
+	lambda := qy.sub(ty).mul(qx.sub(tx).inverse())
+
+	l = py.sub(ty.add(lambda.mul(px.sub(tx))))
+	xr = lambda.square().sub(tx).sub(qx)
+	yr = lambda.mul(tx.sub(xr)).sub(ty)
+	return xr, yr, l
+}
+
+// lineToE12 embeds a line value computed over Fp2 into Fp12 the same way
+// toyCurve's line helper does: as Fp6's C0 coefficient, zero elsewhere.
+func lineToE12(l refFp2) tower.E12[emulated.BN254Fp] {
+	zero := refFp2{big.NewInt(0), big.NewInt(0)}
+	c0 := l.toE2()
+	z := zero.toE2()
+	return tower.E12[emulated.BN254Fp]{
+		C0: &tower.E6[emulated.BN254Fp]{C0: &c0, C1: &z, C2: &z},
+		C1: &tower.E6[emulated.BN254Fp]{C0: &z, C1: &z, C2: &z},
+	}
+}
+
+type doubleStepCircuit struct {
+	T          G2Affine[emulated.BN254Fp]
+	Px, Py     *emulated.Element[emulated.BN254Fp]
+	ExpX, ExpY tower.E2[emulated.BN254Fp]
+	ExpLine    tower.E12[emulated.BN254Fp]
+}
+
+func (c *doubleStepCircuit) Define(api frontend.API) error {
+	ft, err := tower.NewFieldTower[emulated.BN254Fp](api, toyCurve[emulated.BN254Fp]{})
+	if err != nil {
+		return err
+	}
+	p := &G1Affine[emulated.BN254Fp]{X: c.Px, Y: c.Py}
+	xr, line := toyCurve[emulated.BN254Fp]{}.DoubleStep(ft, &c.T, p)
+	ft.Fp2.AssertIsEqual(xr.X, &c.ExpX)
+	ft.Fp2.AssertIsEqual(xr.Y, &c.ExpY)
+	ft.Fp12.AssertIsEqual(line, &c.ExpLine)
+	return nil
+}
+
+func TestToyCurveDoubleStep(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		tx, ty := randRefFp2(), randRefFp2()
+		px, _ := rand.Int(rand.Reader, modulus)
+		py, _ := rand.Int(rand.Reader, modulus)
+
+		xr, yr, l := refDouble(tx, ty, fromFp(px), fromFp(py))
+
+		pxE := emulated.ValueOf[emulated.BN254Fp](px)
+		pyE := emulated.ValueOf[emulated.BN254Fp](py)
+		witness := &doubleStepCircuit{
+			T:       G2Affine[emulated.BN254Fp]{X: ref2Ptr(tx), Y: ref2Ptr(ty)},
+			Px:      &pxE,
+			Py:      &pyE,
+			ExpX:    xr.toE2(),
+			ExpY:    yr.toE2(),
+			ExpLine: lineToE12(l),
+		}
+		assert.CheckCircuit(&doubleStepCircuit{}, test.WithValidAssignment(witness))
+	})
+}
+
+func ref2Ptr(a refFp2) *tower.E2[emulated.BN254Fp] {
+	e := a.toE2()
+	return &e
+}
+
+type addStepCircuit struct {
+	T, Q       G2Affine[emulated.BN254Fp]
+	Px, Py     *emulated.Element[emulated.BN254Fp]
+	ExpX, ExpY tower.E2[emulated.BN254Fp]
+	ExpLine    tower.E12[emulated.BN254Fp]
+}
+
+func (c *addStepCircuit) Define(api frontend.API) error {
+	ft, err := tower.NewFieldTower[emulated.BN254Fp](api, toyCurve[emulated.BN254Fp]{})
+	if err != nil {
+		return err
+	}
+	p := &G1Affine[emulated.BN254Fp]{X: c.Px, Y: c.Py}
+	xr, line := toyCurve[emulated.BN254Fp]{}.AddStep(ft, &c.T, &c.Q, p)
+	ft.Fp2.AssertIsEqual(xr.X, &c.ExpX)
+	ft.Fp2.AssertIsEqual(xr.Y, &c.ExpY)
+	ft.Fp12.AssertIsEqual(line, &c.ExpLine)
+	return nil
+}
+
+func TestToyCurveAddStep(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		tx, ty := randRefFp2(), randRefFp2()
+		qx, qy := randRefFp2(), randRefFp2()
+		px, _ := rand.Int(rand.Reader, modulus)
+		py, _ := rand.Int(rand.Reader, modulus)
+
+		xr, yr, l := refAdd(tx, ty, qx, qy, fromFp(px), fromFp(py))
+
+		pxE := emulated.ValueOf[emulated.BN254Fp](px)
+		pyE := emulated.ValueOf[emulated.BN254Fp](py)
+		witness := &addStepCircuit{
+			T:       G2Affine[emulated.BN254Fp]{X: ref2Ptr(tx), Y: ref2Ptr(ty)},
+			Q:       G2Affine[emulated.BN254Fp]{X: ref2Ptr(qx), Y: ref2Ptr(qy)},
+			Px:      &pxE,
+			Py:      &pyE,
+			ExpX:    xr.toE2(),
+			ExpY:    yr.toE2(),
+			ExpLine: lineToE12(l),
+		}
+		assert.CheckCircuit(&addStepCircuit{}, test.WithValidAssignment(witness))
+	})
+}
+
+// TestPairRoundTrip checks that Pairing.Pair is a deterministic function of
+// its inputs: two independently built circuits given the same (P, Q) agree
+// on the resulting Fp12 element. toyCurve does not implement a real
+// pairing-friendly curve's twist, so this does not check bilinearity (there
+// is none to check); it exercises millerLoop, finalExponentiation and the
+// NAF loop end to end, which is what chunk2-2 asked this package to cover.
+type pairRoundTripCircuit struct {
+	Px, Py *emulated.Element[emulated.BN254Fp]
+	Qx, Qy tower.E2[emulated.BN254Fp]
+}
+
+func (c *pairRoundTripCircuit) Define(api frontend.API) error {
+	p, err := New[emulated.BN254Fp](api, toyCurve[emulated.BN254Fp]{})
+	if err != nil {
+		return err
+	}
+	P := &G1Affine[emulated.BN254Fp]{X: c.Px, Y: c.Py}
+	Q := &G2Affine[emulated.BN254Fp]{X: &c.Qx, Y: &c.Qy}
+
+	res1, err := p.Pair([]*G1Affine[emulated.BN254Fp]{P}, []*G2Affine[emulated.BN254Fp]{Q})
+	if err != nil {
+		return err
+	}
+	res2, err := p.Pair([]*G1Affine[emulated.BN254Fp]{P}, []*G2Affine[emulated.BN254Fp]{Q})
+	if err != nil {
+		return err
+	}
+	p.tower.Fp12.AssertIsEqual(res1, res2)
+	return nil
+}
+
+func TestPairRoundTrip(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		px, _ := rand.Int(rand.Reader, modulus)
+		py, _ := rand.Int(rand.Reader, modulus)
+		qx, qy := randRefFp2(), randRefFp2()
+
+		pxE := emulated.ValueOf[emulated.BN254Fp](px)
+		pyE := emulated.ValueOf[emulated.BN254Fp](py)
+		witness := &pairRoundTripCircuit{
+			Px: &pxE, Py: &pyE,
+			Qx: qx.toE2(), Qy: qy.toE2(),
+		}
+		assert.CheckCircuit(&pairRoundTripCircuit{}, test.WithValidAssignment(witness))
+	})
+}
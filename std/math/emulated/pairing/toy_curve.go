@@ -0,0 +1,128 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package pairing
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/tower"
+)
+
+// toyNonResidues supplies the non-residues β=-1 (Fp2) and ξ=9+u (Fp6/Fp12)
+// used throughout math/emulated/tower's own tests, so toyCurve can build a
+// tower without inventing yet another set of constants. The Frobenius
+// coefficients are placeholders rather than the values a real base field
+// would need: toyCurve's DoubleStep/AddStep never invoke Frobenius, and
+// finalExponentiation's easy part is exercised for its multiplication
+// structure, not for producing a value with any pairing-theoretic meaning.
+type toyNonResidues[T emulated.FieldParams] struct{}
+
+func (toyNonResidues[T]) BetaFp2(fp *emulated.Field[T]) *emulated.Element[T] {
+	return fp.NewElement(big.NewInt(-1))
+}
+
+func (toyNonResidues[T]) FrobeniusCoeffFp2() *big.Int {
+	return big.NewInt(-1)
+}
+
+// XiFp6 returns 9+u, the same Fp6 non-residue math/emulated/tower's own
+// tests use for BN254, built via repeated Add since Field2 exposes no way
+// to construct an arbitrary base-field constant from outside its package.
+func (toyNonResidues[T]) XiFp6(f2 *tower.Field2[T]) *tower.E2[T] {
+	one := f2.One()
+	two := f2.Add(one, one)
+	four := f2.Add(two, two)
+	eight := f2.Add(four, four)
+	nine := f2.Add(eight, one)
+	return &tower.E2[T]{A0: nine.A0, A1: one.A0}
+}
+
+func (toyNonResidues[T]) FrobeniusCoeffsFp6() (gamma1, gamma2 [2]*big.Int) {
+	return [2]*big.Int{big.NewInt(1), big.NewInt(0)}, [2]*big.Int{big.NewInt(1), big.NewInt(0)}
+}
+
+func (toyNonResidues[T]) FrobeniusCoeffFp12() [2]*big.Int {
+	return [2]*big.Int{big.NewInt(1), big.NewInt(0)}
+}
+
+// toyCurve is a deliberately simple Curve implementation: it performs real
+// short-Weierstrass (a=0) point doubling and addition over Fp2, and embeds
+// the resulting tangent/chord line, evaluated at P, into Fp12 as a plain
+// Fp2 element sitting in Fp6's C0 coefficient. A real pairing-friendly
+// curve's line evaluation instead spreads P's coordinates across the Fp12
+// coefficients the sextic twist leaves sparse, which is what lets a Miller
+// loop step cost a sparse rather than a full Fp12 multiplication; toyCurve
+// does the full multiplication instead. It exists to give this package one
+// concrete Curve so millerLoop/finalExponentiation/CyclotomicSquare and the
+// NAF loop in Pairing can be exercised end to end; it is not BN254,
+// BLS12-381, or any other curve used to secure a real proof.
+type toyCurve[T emulated.FieldParams] struct {
+	toyNonResidues[T]
+}
+
+// AteLoopNAF returns the NAF digits of 6 = 0b110 = 2³-2¹, with the leading
+// digit omitted as the interface requires.
+func (toyCurve[T]) AteLoopNAF() []int8 {
+	return []int8{0, -1, 0}
+}
+
+func (toyCurve[T]) AteLoopNegative() bool {
+	return false
+}
+
+// FinalExpHardPart returns a small exponent so expHardPart's
+// square-and-multiply loop runs a handful of iterations in tests.
+func (toyCurve[T]) FinalExpHardPart() *big.Int {
+	return big.NewInt(5)
+}
+
+// embedG1 lifts P's affine Fp coordinates into Fp2 as (x, 0) and (y, 0), so
+// the line function below can be computed over Fp2 alongside T's
+// coordinates.
+func embedG1[T emulated.FieldParams](fp2 *tower.Field2[T], P *G1Affine[T]) (x, y *tower.E2[T]) {
+	return &tower.E2[T]{A0: P.X, A1: fp2.Zero().A1}, &tower.E2[T]{A0: P.Y, A1: fp2.Zero().A1}
+}
+
+// line evaluates the line through T with slope lambda at P, l(P) = P.Y -
+// T.Y - lambda*(P.X - T.X), and embeds the Fp2 result into Fp12 via Fp6's
+// C0 coefficient.
+func line[T emulated.FieldParams](ft *tower.FieldTower[T], T_ *G2Affine[T], lambda *tower.E2[T], P *G1Affine[T]) *LineEvaluation[T] {
+	fp2 := ft.Fp2
+	px, py := embedG1(fp2, P)
+	val := fp2.Sub(py, fp2.Add(T_.Y, fp2.Mul(lambda, fp2.Sub(px, T_.X))))
+	return &tower.E12[T]{
+		C0: &tower.E6[T]{C0: val, C1: fp2.Zero(), C2: fp2.Zero()},
+		C1: &tower.E6[T]{C0: fp2.Zero(), C1: fp2.Zero(), C2: fp2.Zero()},
+	}
+}
+
+// DoubleStep doubles T via lambda = 3x²/2y and returns the new point
+// together with the tangent line at T, evaluated at P.
+func (toyCurve[T]) DoubleStep(ft *tower.FieldTower[T], T *G2Affine[T], P *G1Affine[T]) (*G2Affine[T], *LineEvaluation[T]) {
+	fp2 := ft.Fp2
+	xSq := fp2.Square(T.X)
+	threeXSq := fp2.Add(fp2.Add(xSq, xSq), xSq)
+	twoY := fp2.Add(T.Y, T.Y)
+	lambda := fp2.Mul(threeXSq, fp2.Inverse(twoY))
+
+	l := line(ft, T, lambda, P)
+
+	xr := fp2.Sub(fp2.Square(lambda), fp2.Add(T.X, T.X))
+	yr := fp2.Sub(fp2.Mul(lambda, fp2.Sub(T.X, xr)), T.Y)
+	return &G2Affine[T]{X: xr, Y: yr}, l
+}
+
+// AddStep adds Q into T via lambda = (Q.Y-T.Y)/(Q.X-T.X) and returns the
+// new point together with the line through T and Q, evaluated at P.
+func (toyCurve[T]) AddStep(ft *tower.FieldTower[T], T, Q *G2Affine[T], P *G1Affine[T]) (*G2Affine[T], *LineEvaluation[T]) {
+	fp2 := ft.Fp2
+	lambda := fp2.Mul(fp2.Sub(Q.Y, T.Y), fp2.Inverse(fp2.Sub(Q.X, T.X)))
+
+	l := line(ft, T, lambda, P)
+
+	xr := fp2.Sub(fp2.Sub(fp2.Square(lambda), T.X), Q.X)
+	yr := fp2.Sub(fp2.Mul(lambda, fp2.Sub(T.X, xr)), T.Y)
+	return &G2Affine[T]{X: xr, Y: yr}, l
+}
@@ -0,0 +1,179 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package emulated
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+)
+
+func init() {
+	solver.RegisterHint(sqrtHint)
+}
+
+// nonResidueCache caches, per FieldParams type, a quadratic non-residue of
+// the field's modulus. It is looked up once per T and reused by every Sqrt /
+// IsSquare / Legendre call for that T, as the request sketch requires.
+var nonResidueCache sync.Map // map[reflect.Type]*big.Int
+
+func quadraticNonResidue(p *big.Int, t reflect.Type) *big.Int {
+	if v, ok := nonResidueCache.Load(t); ok {
+		return v.(*big.Int)
+	}
+	exp := new(big.Int).Rsh(new(big.Int).Sub(p, big.NewInt(1)), 1)
+	pMinusOne := new(big.Int).Sub(p, big.NewInt(1))
+	z := big.NewInt(2)
+	for new(big.Int).Exp(z, exp, p).Cmp(pMinusOne) != 0 {
+		z.Add(z, big.NewInt(1))
+	}
+	nonResidueCache.Store(t, z)
+	return z
+}
+
+// sqrtHint computes a square root of inputs[2] modulo inputs[0] using
+// Tonelli-Shanks, together with a bit recording whether inputs[2] is a
+// quadratic residue at all. inputs[1] is a quadratic non-residue of
+// inputs[0], used both by the general algorithm and to produce a witness
+// value when inputs[2] has no square root.
+//
+//   - outputs[0]: a square root of inputs[2] if it is a square, otherwise a
+//     square root of inputs[1]*inputs[2] (which is then a square, as the
+//     product of two non-residues... actually the product of a non-residue
+//     by a non-residue is a residue, so this is always solvable).
+//   - outputs[1]: 1 if inputs[2] is a quadratic residue mod inputs[0], 0
+//     otherwise.
+func sqrtHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	if len(inputs) != 3 {
+		return fmt.Errorf("sqrtHint expects 3 inputs, got %d", len(inputs))
+	}
+	p := inputs[0]
+	z := inputs[1]
+	a := new(big.Int).Mod(inputs[2], p)
+
+	if a.Sign() == 0 {
+		outputs[0] = big.NewInt(0)
+		outputs[1] = big.NewInt(1)
+		return nil
+	}
+
+	one := big.NewInt(1)
+	pMinusOne := new(big.Int).Sub(p, one)
+
+	isSquare := new(big.Int).Exp(a, new(big.Int).Rsh(pMinusOne, 1), p).Cmp(one) == 0
+	target := a
+	if !isSquare {
+		target = new(big.Int).Mod(new(big.Int).Mul(z, a), p)
+	}
+
+	// fast path: p = 3 mod 4
+	if new(big.Int).And(p, big.NewInt(3)).Int64() == 3 {
+		exp := new(big.Int).Rsh(new(big.Int).Add(p, one), 2)
+		x := new(big.Int).Exp(target, exp, p)
+		outputs[0] = x
+		if isSquare {
+			outputs[1] = big.NewInt(1)
+		} else {
+			outputs[1] = big.NewInt(0)
+		}
+		return nil
+	}
+
+	// general Tonelli-Shanks: p-1 = q * 2^s, q odd.
+	q := new(big.Int).Set(pMinusOne)
+	s := 0
+	for q.Bit(0) == 0 {
+		q.Rsh(q, 1)
+		s++
+	}
+
+	x := new(big.Int).Exp(target, new(big.Int).Rsh(new(big.Int).Add(q, one), 1), p)
+	b := new(big.Int).Exp(target, q, p)
+	g := new(big.Int).Exp(z, q, p)
+	r := s
+
+	for {
+		if b.Cmp(one) == 0 {
+			break
+		}
+		// find least m, 0 < m < r, with b^(2^m) == 1
+		m := 0
+		bm := new(big.Int).Set(b)
+		for bm.Cmp(one) != 0 {
+			bm.Mod(new(big.Int).Mul(bm, bm), p)
+			m++
+		}
+		t := new(big.Int).Exp(g, new(big.Int).Lsh(one, uint(r-m-1)), p)
+		x.Mod(new(big.Int).Mul(x, t), p)
+		g.Mod(new(big.Int).Mul(t, t), p)
+		b.Mod(new(big.Int).Mul(b, g), p)
+		r = m
+	}
+
+	outputs[0] = x
+	if isSquare {
+		outputs[1] = big.NewInt(1)
+	} else {
+		outputs[1] = big.NewInt(0)
+	}
+	return nil
+}
+
+// sqrtAndLegendre is the shared implementation behind Sqrt, IsSquare and
+// Legendre: it runs Tonelli-Shanks out of circuit via a hint, then asserts
+// the result is consistent with the input in-circuit.
+func (f *Field[T]) sqrtAndLegendre(a *Element[T]) (root *Element[T], isSquare frontend.Variable) {
+	var fp T
+	p := fp.Modulus()
+	z := quadraticNonResidue(p, reflect.TypeOf(fp))
+
+	pElement := f.NewElement(p)
+	zElement := f.NewElement(z)
+
+	outputs, err := f.NewHint(sqrtHint, 2, pElement, zElement, a)
+	if err != nil {
+		panic(fmt.Sprintf("sqrt hint: %v", err))
+	}
+	x, isSquareElement := outputs[0], f.Reduce(outputs[1])
+
+	isSquareBit := isSquareElement.Limbs[0]
+	f.api.AssertIsBoolean(isSquareBit)
+	for _, limb := range isSquareElement.Limbs[1:] {
+		f.api.AssertIsEqual(limb, 0)
+	}
+
+	lhs := f.MulMod(x, x)
+	zTimesA := f.MulMod(zElement, a)
+	rhs := f.Select(isSquareBit, a, zTimesA)
+	f.AssertIsEqual(lhs, rhs)
+
+	return x, isSquareBit
+}
+
+// Sqrt returns a square root of a modulo the field's modulus. If a is not a
+// quadratic residue, the returned element satisfies no particular relation
+// to a; use IsSquare to check whether a has a square root at all.
+func (f *Field[T]) Sqrt(a *Element[T]) *Element[T] {
+	root, _ := f.sqrtAndLegendre(a)
+	return root
+}
+
+// IsSquare returns 1 if a is a quadratic residue modulo the field's modulus
+// (including 0), and 0 otherwise.
+func (f *Field[T]) IsSquare(a *Element[T]) frontend.Variable {
+	_, isSquare := f.sqrtAndLegendre(a)
+	return isSquare
+}
+
+// Legendre returns the Legendre symbol of a: 1 if a is a non-zero quadratic
+// residue, -1 if a is a non-residue, and 1 if a is zero (matching IsSquare's
+// convention that 0 is a square).
+func (f *Field[T]) Legendre(a *Element[T]) frontend.Variable {
+	_, isSquare := f.sqrtAndLegendre(a)
+	return f.api.Sub(1, f.api.Mul(2, f.api.Sub(1, isSquare)))
+}
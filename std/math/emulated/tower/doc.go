@@ -0,0 +1,17 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package tower builds quadratic, cubic and 12-th degree extensions on top
+// of [github.com/consensys/gnark/std/math/emulated].Field[T], so that
+// circuits can work with the Fp2/Fp6/Fp12 towers optimal-ate pairings are
+// built from, over a base field that is itself emulated (i.e. foreign to
+// the circuit's native curve). This is what lets a circuit verify a
+// pairing-based proof from a different curve than the one it is compiled
+// for.
+//
+// Every operation is expressed in terms of Field[T].Add/Sub/Mul and friends,
+// so it reuses that package's deferred-reduction machinery instead of
+// reimplementing modular arithmetic: a quadratic multiplication costs the
+// same handful of Field[T].Mul calls regardless of which curve's base field
+// T represents.
+package tower
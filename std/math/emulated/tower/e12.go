@@ -0,0 +1,129 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package tower
+
+import (
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// E12 is an element c0 + c1·w of Fp12 = Fp6[w]/(w²-v), where v is the degree
+// one basis element of Fp6 itself (i.e. Fp12 is built directly on top of
+// Field6, with no separate non-residue to parameterize).
+type E12[T emulated.FieldParams] struct {
+	C0, C1 *E6[T]
+}
+
+// Field12 implements arithmetic over E12[T].
+type Field12[T emulated.FieldParams] struct {
+	f6    *Field6[T]
+	v     *E6[T]
+	gamma *E6[T]
+}
+
+// NewField12 builds a Field12 for the extension Fp6[w]/(w²-v), with nr
+// supplying the Fp12 Frobenius constant (w^p = γ·w, embedded as the C0
+// coefficient of an otherwise-zero E6 so Frobenius can reuse Field6.Mul).
+func NewField12[T emulated.FieldParams](f6 *Field6[T], nr Fp6NonResidue[T]) *Field12[T] {
+	g := nr.FrobeniusCoeffFp12()
+	gamma := &E6[T]{
+		C0: &E2[T]{A0: f6.f2.fp.NewElement(g[0]), A1: f6.f2.fp.NewElement(g[1])},
+		C1: f6.f2.Zero(),
+		C2: f6.f2.Zero(),
+	}
+	return &Field12[T]{f6: f6, v: f6.generator(), gamma: gamma}
+}
+
+// Frobenius returns a^p = Frob6(d0) + γ·Frob6(d1), the p-power map on
+// Fp12/Fp6.
+func (e *Field12[T]) Frobenius(a *E12[T]) *E12[T] {
+	return &E12[T]{
+		C0: e.f6.Frobenius(a.C0),
+		C1: e.f6.Mul(e.gamma, e.f6.Frobenius(a.C1)),
+	}
+}
+
+// Zero returns 0.
+func (e *Field12[T]) Zero() *E12[T] {
+	return &E12[T]{C0: e.f6.Zero(), C1: e.f6.Zero()}
+}
+
+// One returns 1.
+func (e *Field12[T]) One() *E12[T] {
+	return &E12[T]{C0: e.f6.One(), C1: e.f6.Zero()}
+}
+
+// Add returns a+b.
+func (e *Field12[T]) Add(a, b *E12[T]) *E12[T] {
+	return &E12[T]{C0: e.f6.Add(a.C0, b.C0), C1: e.f6.Add(a.C1, b.C1)}
+}
+
+// Sub returns a-b.
+func (e *Field12[T]) Sub(a, b *E12[T]) *E12[T] {
+	return &E12[T]{C0: e.f6.Sub(a.C0, b.C0), C1: e.f6.Sub(a.C1, b.C1)}
+}
+
+// Neg returns -a.
+func (e *Field12[T]) Neg(a *E12[T]) *E12[T] {
+	return &E12[T]{C0: e.f6.Neg(a.C0), C1: e.f6.Neg(a.C1)}
+}
+
+// Conjugate returns c0 - c1·w, the Frobenius of order 6 (p^6-power) map
+// restricted to the quadratic layer.
+func (e *Field12[T]) Conjugate(a *E12[T]) *E12[T] {
+	return &E12[T]{C0: a.C0, C1: e.f6.Neg(a.C1)}
+}
+
+// Mul returns a*b via Karatsuba over Field6.
+func (e *Field12[T]) Mul(a, b *E12[T]) *E12[T] {
+	t0 := e.f6.Mul(a.C0, b.C0)
+	t1 := e.f6.Mul(a.C1, b.C1)
+	sum := e.f6.Mul(e.f6.Add(a.C0, a.C1), e.f6.Add(b.C0, b.C1))
+
+	return &E12[T]{
+		C0: e.f6.Add(t0, e.f6.MulByNonResidue(t1)),
+		C1: e.f6.Sub(e.f6.Sub(sum, t0), t1),
+	}
+}
+
+// Square returns a² via the same complex-squaring trick used by Field2.
+func (e *Field12[T]) Square(a *E12[T]) *E12[T] {
+	t0 := e.f6.Mul(e.f6.Add(a.C0, a.C1), e.f6.Add(a.C0, e.f6.MulByNonResidue(a.C1)))
+	t1 := e.f6.Mul(a.C0, a.C1)
+	return &E12[T]{
+		C0: e.f6.Sub(t0, e.f6.Add(t1, e.f6.MulByNonResidue(t1))),
+		C1: e.f6.Add(t1, t1),
+	}
+}
+
+// CyclotomicSquare specializes Square for elements of the cyclotomic
+// subgroup (those satisfying a^(p^6+1)=1, as Miller loop accumulators do
+// after the easy part of the final exponentiation); for now it falls back to
+// the general squaring formula above, which remains correct on that
+// subgroup, just not optimal.
+func (e *Field12[T]) CyclotomicSquare(a *E12[T]) *E12[T] {
+	return e.Square(a)
+}
+
+// norm returns c0² - v·c1².
+func (e *Field12[T]) norm(a *E12[T]) *E6[T] {
+	c0Sq := e.f6.Mul(a.C0, a.C0)
+	c1Sq := e.f6.Mul(a.C1, a.C1)
+	return e.f6.Sub(c0Sq, e.f6.MulByNonResidue(c1Sq))
+}
+
+// Inverse returns a⁻¹ = (c0 - c1·w) / (c0² - v·c1²).
+func (e *Field12[T]) Inverse(a *E12[T]) *E12[T] {
+	n := e.norm(a)
+	nInv := e.f6.Inverse(n)
+	return &E12[T]{
+		C0: e.f6.Mul(a.C0, nInv),
+		C1: e.f6.Mul(e.f6.Neg(a.C1), nInv),
+	}
+}
+
+// AssertIsEqual asserts a == b coefficient-wise.
+func (e *Field12[T]) AssertIsEqual(a, b *E12[T]) {
+	e.f6.AssertIsEqual(a.C0, b.C0)
+	e.f6.AssertIsEqual(a.C1, b.C1)
+}
@@ -0,0 +1,129 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package tower
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// Fp2NonResidue describes the non-residue β used to build the quadratic
+// extension Fp2 = Fp[u]/(u²-β) of the base field represented by T.
+type Fp2NonResidue[T emulated.FieldParams] interface {
+	// BetaFp2 returns β as an element of the base field.
+	BetaFp2(fp *emulated.Field[T]) *emulated.Element[T]
+	// FrobeniusCoeffFp2 is the constant c = β^((p-1)/2) such that u^p = c·u,
+	// i.e. applying Frobenius to Fp2 amounts to negating u when c = -1.
+	FrobeniusCoeffFp2() *big.Int
+}
+
+// E2 is an element a0 + a1·u of Fp2 = Fp[u]/(u²-β).
+type E2[T emulated.FieldParams] struct {
+	A0, A1 *emulated.Element[T]
+}
+
+// Field2 implements arithmetic over E2[T].
+type Field2[T emulated.FieldParams] struct {
+	fp        *emulated.Field[T]
+	beta      *emulated.Element[T]
+	frobCoeff *emulated.Element[T]
+}
+
+// NewField2 builds a Field2 for the extension Fp[u]/(u²-β) described by nr.
+func NewField2[T emulated.FieldParams](fp *emulated.Field[T], nr Fp2NonResidue[T]) *Field2[T] {
+	return &Field2[T]{fp: fp, beta: nr.BetaFp2(fp), frobCoeff: fp.NewElement(nr.FrobeniusCoeffFp2())}
+}
+
+// Frobenius returns a^p = a0 + c·a1·u, the p-power map on Fp2/Fp.
+func (e *Field2[T]) Frobenius(a *E2[T]) *E2[T] {
+	return &E2[T]{A0: a.A0, A1: e.fp.MulMod(e.frobCoeff, a.A1)}
+}
+
+// Zero returns 0 + 0u.
+func (e *Field2[T]) Zero() *E2[T] {
+	return &E2[T]{A0: e.fp.Zero(), A1: e.fp.Zero()}
+}
+
+// One returns 1 + 0u.
+func (e *Field2[T]) One() *E2[T] {
+	return &E2[T]{A0: e.fp.One(), A1: e.fp.Zero()}
+}
+
+// Add returns a+b.
+func (e *Field2[T]) Add(a, b *E2[T]) *E2[T] {
+	return &E2[T]{A0: e.fp.Add(a.A0, b.A0), A1: e.fp.Add(a.A1, b.A1)}
+}
+
+// Sub returns a-b.
+func (e *Field2[T]) Sub(a, b *E2[T]) *E2[T] {
+	return &E2[T]{A0: e.fp.Sub(a.A0, b.A0), A1: e.fp.Sub(a.A1, b.A1)}
+}
+
+// Neg returns -a.
+func (e *Field2[T]) Neg(a *E2[T]) *E2[T] {
+	return &E2[T]{A0: e.fp.Neg(a.A0), A1: e.fp.Neg(a.A1)}
+}
+
+// Conjugate returns a0 - a1·u.
+func (e *Field2[T]) Conjugate(a *E2[T]) *E2[T] {
+	return &E2[T]{A0: a.A0, A1: e.fp.Neg(a.A1)}
+}
+
+// MulByNonResidue returns a·u, i.e. 0 + 1·u multiplied into a. Field6 does
+// not call it: it multiplies by ξ with Mul.
+func (e *Field2[T]) MulByNonResidue(a *E2[T]) *E2[T] {
+	return &E2[T]{A0: e.fp.MulMod(e.beta, a.A1), A1: a.A0}
+}
+
+// Mul returns a*b via Karatsuba: three Field[T].MulMod products (plus one
+// MulMod to scale a1·b1 by β) instead of the four products a schoolbook
+// expansion would need.
+func (e *Field2[T]) Mul(a, b *E2[T]) *E2[T] {
+	a0b0 := e.fp.MulMod(a.A0, b.A0)
+	a1b1 := e.fp.MulMod(a.A1, b.A1)
+	sum := e.fp.MulMod(e.fp.Add(a.A0, a.A1), e.fp.Add(b.A0, b.A1))
+
+	betaA1b1 := e.fp.MulMod(e.beta, a1b1)
+	return &E2[T]{
+		A0: e.fp.Add(a0b0, betaA1b1),
+		A1: e.fp.Sub(e.fp.Sub(sum, a0b0), a1b1),
+	}
+}
+
+// Square returns a², using the complex-squaring trick
+// (a0+a1)(a0+βa1) - a0a1(1+β) to trade a multiplication for extra additions.
+func (e *Field2[T]) Square(a *E2[T]) *E2[T] {
+	betaA1 := e.fp.MulMod(e.beta, a.A1)
+	t0 := e.fp.MulMod(e.fp.Add(a.A0, a.A1), e.fp.Add(a.A0, betaA1))
+	t1 := e.fp.MulMod(a.A0, a.A1)
+	betaT1 := e.fp.MulMod(e.beta, t1)
+	return &E2[T]{
+		A0: e.fp.Sub(t0, e.fp.Add(t1, betaT1)),
+		A1: e.fp.Add(t1, t1),
+	}
+}
+
+// norm returns a0² - β·a1², the field norm of a down to the base field.
+func (e *Field2[T]) norm(a *E2[T]) *emulated.Element[T] {
+	a0Sq := e.fp.MulMod(a.A0, a.A0)
+	a1Sq := e.fp.MulMod(a.A1, a.A1)
+	return e.fp.Sub(a0Sq, e.fp.MulMod(e.beta, a1Sq))
+}
+
+// Inverse returns a⁻¹ = (a0 - a1u) / (a0²-βa1²).
+func (e *Field2[T]) Inverse(a *E2[T]) *E2[T] {
+	n := e.norm(a)
+	nInv := e.fp.Inverse(n)
+	return &E2[T]{
+		A0: e.fp.MulMod(a.A0, nInv),
+		A1: e.fp.MulMod(e.fp.Neg(a.A1), nInv),
+	}
+}
+
+// AssertIsEqual asserts a == b coefficient-wise.
+func (e *Field2[T]) AssertIsEqual(a, b *E2[T]) {
+	e.fp.AssertIsEqual(a.A0, b.A0)
+	e.fp.AssertIsEqual(a.A1, b.A1)
+}
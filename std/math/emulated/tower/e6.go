@@ -0,0 +1,149 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package tower
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// Fp6NonResidue describes the non-residue ξ used to build the cubic
+// extension Fp6 = Fp2[v]/(v³-ξ), along with the Frobenius constants needed
+// at the Fp6 and Fp12 layers.
+type Fp6NonResidue[T emulated.FieldParams] interface {
+	XiFp6(f2 *Field2[T]) *E2[T]
+	// FrobeniusCoeffsFp6 are γ1 = ξ^((p-1)/3) and γ2 = ξ^(2(p-1)/3), each as
+	// an Fp2 element's (real, imaginary) big.Int pair, such that v^p = γ1·v
+	// and v^(2p) = γ2·v².
+	FrobeniusCoeffsFp6() (gamma1, gamma2 [2]*big.Int)
+	// FrobeniusCoeffFp12 is ξ^((p-1)/6), the Fp2 constant (as a (real,
+	// imaginary) pair) such that w^p = γ·w in Fp12 = Fp6[w]/(w²-v).
+	FrobeniusCoeffFp12() [2]*big.Int
+}
+
+// E6 is an element c0 + c1·v + c2·v² of Fp6 = Fp2[v]/(v³-ξ).
+type E6[T emulated.FieldParams] struct {
+	C0, C1, C2 *E2[T]
+}
+
+// Field6 implements arithmetic over E6[T].
+type Field6[T emulated.FieldParams] struct {
+	f2     *Field2[T]
+	xi     *E2[T]
+	gamma1 *E2[T]
+	gamma2 *E2[T]
+}
+
+// NewField6 builds a Field6 for the extension Fp2[v]/(v³-ξ) described by nr.
+func NewField6[T emulated.FieldParams](f2 *Field2[T], nr Fp6NonResidue[T]) *Field6[T] {
+	g1, g2 := nr.FrobeniusCoeffsFp6()
+	return &Field6[T]{
+		f2:     f2,
+		xi:     nr.XiFp6(f2),
+		gamma1: &E2[T]{A0: f2.fp.NewElement(g1[0]), A1: f2.fp.NewElement(g1[1])},
+		gamma2: &E2[T]{A0: f2.fp.NewElement(g2[0]), A1: f2.fp.NewElement(g2[1])},
+	}
+}
+
+// Frobenius returns a^p = Frob2(c0) + γ1·Frob2(c1)·v + γ2·Frob2(c2)·v², the
+// p-power map on Fp6/Fp2.
+func (e *Field6[T]) Frobenius(a *E6[T]) *E6[T] {
+	return &E6[T]{
+		C0: e.f2.Frobenius(a.C0),
+		C1: e.f2.Mul(e.gamma1, e.f2.Frobenius(a.C1)),
+		C2: e.f2.Mul(e.gamma2, e.f2.Frobenius(a.C2)),
+	}
+}
+
+// Zero returns 0.
+func (e *Field6[T]) Zero() *E6[T] {
+	return &E6[T]{C0: e.f2.Zero(), C1: e.f2.Zero(), C2: e.f2.Zero()}
+}
+
+// One returns 1.
+func (e *Field6[T]) One() *E6[T] {
+	return &E6[T]{C0: e.f2.One(), C1: e.f2.Zero(), C2: e.f2.Zero()}
+}
+
+// generator returns v (the E6 element with coefficient 1 on v, 0 elsewhere);
+// it is the non-residue Field12 builds w²=v out of.
+func (e *Field6[T]) generator() *E6[T] {
+	return &E6[T]{C0: e.f2.Zero(), C1: e.f2.One(), C2: e.f2.Zero()}
+}
+
+// Add returns a+b.
+func (e *Field6[T]) Add(a, b *E6[T]) *E6[T] {
+	return &E6[T]{C0: e.f2.Add(a.C0, b.C0), C1: e.f2.Add(a.C1, b.C1), C2: e.f2.Add(a.C2, b.C2)}
+}
+
+// Sub returns a-b.
+func (e *Field6[T]) Sub(a, b *E6[T]) *E6[T] {
+	return &E6[T]{C0: e.f2.Sub(a.C0, b.C0), C1: e.f2.Sub(a.C1, b.C1), C2: e.f2.Sub(a.C2, b.C2)}
+}
+
+// Neg returns -a.
+func (e *Field6[T]) Neg(a *E6[T]) *E6[T] {
+	return &E6[T]{C0: e.f2.Neg(a.C0), C1: e.f2.Neg(a.C1), C2: e.f2.Neg(a.C2)}
+}
+
+// MulByNonResidue returns a·v.
+func (e *Field6[T]) MulByNonResidue(a *E6[T]) *E6[T] {
+	return &E6[T]{C0: e.f2.Mul(e.xi, a.C2), C1: a.C0, C2: a.C1}
+}
+
+// Mul returns a*b using the standard cubic-extension expansion over Fp2.
+func (e *Field6[T]) Mul(a, b *E6[T]) *E6[T] {
+	t0 := e.f2.Mul(a.C0, b.C0)
+	t1 := e.f2.Mul(a.C1, b.C1)
+	t2 := e.f2.Mul(a.C2, b.C2)
+
+	c0 := e.f2.Add(t0, e.f2.Mul(e.xi, e.f2.Sub(e.f2.Sub(e.f2.Mul(e.f2.Add(a.C1, a.C2), e.f2.Add(b.C1, b.C2)), t1), t2)))
+	c1 := e.f2.Add(e.f2.Sub(e.f2.Sub(e.f2.Mul(e.f2.Add(a.C0, a.C1), e.f2.Add(b.C0, b.C1)), t0), t1), e.f2.Mul(e.xi, t2))
+	c2 := e.f2.Add(e.f2.Sub(e.f2.Sub(e.f2.Mul(e.f2.Add(a.C0, a.C2), e.f2.Add(b.C0, b.C2)), t0), t2), t1)
+
+	return &E6[T]{C0: c0, C1: c1, C2: c2}
+}
+
+// Square returns a² via the Chung-Hasan SQR2 formula, trading the cubic
+// extension's usual six Fp2 multiplications for three squarings and two
+// multiplications.
+func (e *Field6[T]) Square(a *E6[T]) *E6[T] {
+	s0 := e.f2.Square(a.C0)
+	s1 := e.f2.Mul(a.C0, a.C1)
+	s1 = e.f2.Add(s1, s1)
+	s2 := e.f2.Square(e.f2.Add(e.f2.Sub(a.C0, a.C1), a.C2))
+	s3 := e.f2.Mul(a.C1, a.C2)
+	s3 = e.f2.Add(s3, s3)
+	s4 := e.f2.Square(a.C2)
+
+	return &E6[T]{
+		C0: e.f2.Add(s0, e.f2.Mul(e.xi, s3)),
+		C1: e.f2.Add(s1, e.f2.Mul(e.xi, s4)),
+		C2: e.f2.Sub(e.f2.Sub(e.f2.Add(e.f2.Add(s1, s2), s3), s0), s4),
+	}
+}
+
+// Inverse returns a⁻¹ via the standard cubic-extension inversion formula.
+func (e *Field6[T]) Inverse(a *E6[T]) *E6[T] {
+	t0 := e.f2.Sub(e.f2.Mul(a.C0, a.C0), e.f2.Mul(e.xi, e.f2.Mul(a.C1, a.C2)))
+	t1 := e.f2.Sub(e.f2.Mul(e.xi, e.f2.Mul(a.C2, a.C2)), e.f2.Mul(a.C0, a.C1))
+	t2 := e.f2.Sub(e.f2.Mul(a.C1, a.C1), e.f2.Mul(a.C0, a.C2))
+
+	norm := e.f2.Add(e.f2.Mul(a.C0, t0), e.f2.Mul(e.xi, e.f2.Add(e.f2.Mul(a.C2, t1), e.f2.Mul(a.C1, t2))))
+	normInv := e.f2.Inverse(norm)
+
+	return &E6[T]{
+		C0: e.f2.Mul(t0, normInv),
+		C1: e.f2.Mul(t1, normInv),
+		C2: e.f2.Mul(t2, normInv),
+	}
+}
+
+// AssertIsEqual asserts a == b coefficient-wise.
+func (e *Field6[T]) AssertIsEqual(a, b *E6[T]) {
+	e.f2.AssertIsEqual(a.C0, b.C0)
+	e.f2.AssertIsEqual(a.C1, b.C1)
+	e.f2.AssertIsEqual(a.C2, b.C2)
+}
@@ -0,0 +1,41 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package tower
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// FieldTower bundles the Field2/Field6/Field12 builders for a single base
+// field T, so that a circuit verifying, say, a BN254 or BLS12-381 pairing
+// over a different outer curve only needs to carry around one value.
+type FieldTower[T emulated.FieldParams] struct {
+	Fp2  *Field2[T]
+	Fp6  *Field6[T]
+	Fp12 *Field12[T]
+}
+
+// NonResidues describes the two non-residues the tower needs to be built
+// out of a base field (Fp12's is fixed: it is always v, the degree-one
+// basis element of Fp6).
+type NonResidues[T emulated.FieldParams] interface {
+	Fp2NonResidue[T]
+	Fp6NonResidue[T]
+}
+
+// NewFieldTower builds the Fp2/Fp6/Fp12 tower on top of emulated.Field[T],
+// using nr to describe the non-residues defined by NonResidues.
+func NewFieldTower[T emulated.FieldParams](api frontend.API, nr NonResidues[T]) (*FieldTower[T], error) {
+	fp, err := emulated.NewField[T](api)
+	if err != nil {
+		return nil, fmt.Errorf("new field: %w", err)
+	}
+	fp2 := NewField2[T](fp, nr)
+	fp6 := NewField6[T](fp2, nr)
+	fp12 := NewField12[T](fp6, nr)
+	return &FieldTower[T]{Fp2: fp2, Fp6: fp6, Fp12: fp12}, nil
+}
@@ -0,0 +1,323 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package tower
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+// testNonResidues implements NonResidues[emulated.BN254Fp] with the standard
+// BN254 tower constants (β=-1 for Fp2, ξ=9+u for Fp6). The Frobenius
+// coefficients are left at placeholder values: none of the tests in this
+// file exercise Frobenius, only Add/Mul/Square/Inverse, so they don't need
+// to be the real BN254 values to make those tests meaningful.
+type testNonResidues struct{}
+
+func (testNonResidues) BetaFp2(fp *emulated.Field[emulated.BN254Fp]) *emulated.Element[emulated.BN254Fp] {
+	return fp.NewElement(big.NewInt(-1))
+}
+
+func (testNonResidues) FrobeniusCoeffFp2() *big.Int {
+	return big.NewInt(-1)
+}
+
+func (testNonResidues) XiFp6(f2 *Field2[emulated.BN254Fp]) *E2[emulated.BN254Fp] {
+	return &E2[emulated.BN254Fp]{A0: f2.fp.NewElement(big.NewInt(9)), A1: f2.fp.NewElement(big.NewInt(1))}
+}
+
+func (testNonResidues) FrobeniusCoeffsFp6() (gamma1, gamma2 [2]*big.Int) {
+	return [2]*big.Int{big.NewInt(1), big.NewInt(0)}, [2]*big.Int{big.NewInt(1), big.NewInt(0)}
+}
+
+func (testNonResidues) FrobeniusCoeffFp12() [2]*big.Int {
+	return [2]*big.Int{big.NewInt(1), big.NewInt(0)}
+}
+
+var modulus = func() *big.Int {
+	var fp emulated.BN254Fp
+	return fp.Modulus()
+}()
+
+func modReduce(x *big.Int) *big.Int {
+	return new(big.Int).Mod(x, modulus)
+}
+
+// --- independent big.Int reference arithmetic for Fp2=Fp[u]/(u²+1),
+// Fp6=Fp2[v]/(v³-ξ) and Fp12=Fp6[w]/(w²-v), built from plain schoolbook
+// expansion rather than the in-circuit Karatsuba/complex-squaring tricks,
+// so a bug in the latter can't also be baked into the former. ---
+
+type refFp2 struct{ A0, A1 *big.Int }
+
+var refXi = refFp2{big.NewInt(9), big.NewInt(1)}
+
+func zeroFp2() refFp2 { return refFp2{big.NewInt(0), big.NewInt(0)} }
+
+func randRefFp2() refFp2 {
+	a0, _ := rand.Int(rand.Reader, modulus)
+	a1, _ := rand.Int(rand.Reader, modulus)
+	return refFp2{a0, a1}
+}
+
+func (a refFp2) add(b refFp2) refFp2 {
+	return refFp2{modReduce(new(big.Int).Add(a.A0, b.A0)), modReduce(new(big.Int).Add(a.A1, b.A1))}
+}
+
+func (a refFp2) neg() refFp2 {
+	return refFp2{modReduce(new(big.Int).Neg(a.A0)), modReduce(new(big.Int).Neg(a.A1))}
+}
+
+func (a refFp2) sub(b refFp2) refFp2 {
+	return a.add(b.neg())
+}
+
+// mul computes a*b in Fp[u]/(u²+1) via plain schoolbook expansion.
+func (a refFp2) mul(b refFp2) refFp2 {
+	a0b0 := new(big.Int).Mul(a.A0, b.A0)
+	a1b1 := new(big.Int).Mul(a.A1, b.A1)
+	a0b1 := new(big.Int).Mul(a.A0, b.A1)
+	a1b0 := new(big.Int).Mul(a.A1, b.A0)
+	c0 := new(big.Int).Sub(a0b0, a1b1) // β = -1
+	c1 := new(big.Int).Add(a0b1, a1b0)
+	return refFp2{modReduce(c0), modReduce(c1)}
+}
+
+func (a refFp2) square() refFp2 {
+	return a.mul(a)
+}
+
+func (a refFp2) inverse() refFp2 {
+	a0Sq := new(big.Int).Mul(a.A0, a.A0)
+	a1Sq := new(big.Int).Mul(a.A1, a.A1)
+	norm := modReduce(new(big.Int).Add(a0Sq, a1Sq)) // a0²-β·a1² = a0²+a1²
+	normInv := new(big.Int).ModInverse(norm, modulus)
+	c0 := modReduce(new(big.Int).Mul(a.A0, normInv))
+	c1 := modReduce(new(big.Int).Mul(new(big.Int).Neg(a.A1), normInv))
+	return refFp2{c0, c1}
+}
+
+func (a refFp2) toE2() E2[emulated.BN254Fp] {
+	a0 := emulated.ValueOf[emulated.BN254Fp](a.A0)
+	a1 := emulated.ValueOf[emulated.BN254Fp](a.A1)
+	return E2[emulated.BN254Fp]{A0: &a0, A1: &a1}
+}
+
+type fp2OpCircuit struct {
+	A, B                          E2[emulated.BN254Fp]
+	ExpAdd, ExpMul, ExpSq, ExpInv E2[emulated.BN254Fp]
+}
+
+func (c *fp2OpCircuit) Define(api frontend.API) error {
+	fp, err := emulated.NewField[emulated.BN254Fp](api)
+	if err != nil {
+		return err
+	}
+	f2 := NewField2[emulated.BN254Fp](fp, testNonResidues{})
+	f2.AssertIsEqual(f2.Add(&c.A, &c.B), &c.ExpAdd)
+	f2.AssertIsEqual(f2.Mul(&c.A, &c.B), &c.ExpMul)
+	f2.AssertIsEqual(f2.Square(&c.A), &c.ExpSq)
+	f2.AssertIsEqual(f2.Inverse(&c.A), &c.ExpInv)
+	return nil
+}
+
+func TestField2Arithmetic(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		a, b := randRefFp2(), randRefFp2()
+		witness := &fp2OpCircuit{
+			A: a.toE2(), B: b.toE2(),
+			ExpAdd: a.add(b).toE2(),
+			ExpMul: a.mul(b).toE2(),
+			ExpSq:  a.square().toE2(),
+			ExpInv: a.inverse().toE2(),
+		}
+		assert.CheckCircuit(&fp2OpCircuit{}, test.WithValidAssignment(witness))
+	})
+}
+
+// refFp6 is Fp2[v]/(v³-ξ), with ξ=9+u as returned by testNonResidues.XiFp6.
+type refFp6 struct{ C0, C1, C2 refFp2 }
+
+func zeroFp6() refFp6 { return refFp6{zeroFp2(), zeroFp2(), zeroFp2()} }
+
+func randRefFp6() refFp6 {
+	return refFp6{randRefFp2(), randRefFp2(), randRefFp2()}
+}
+
+func (a refFp6) add(b refFp6) refFp6 {
+	return refFp6{a.C0.add(b.C0), a.C1.add(b.C1), a.C2.add(b.C2)}
+}
+
+func (a refFp6) neg() refFp6 {
+	return refFp6{a.C0.neg(), a.C1.neg(), a.C2.neg()}
+}
+
+func (a refFp6) sub(b refFp6) refFp6 {
+	return a.add(b.neg())
+}
+
+// mul computes a*b in Fp2[v]/(v³-ξ) via plain schoolbook expansion.
+func (a refFp6) mul(b refFp6) refFp6 {
+	t00 := a.C0.mul(b.C0)
+	t01 := a.C0.mul(b.C1)
+	t02 := a.C0.mul(b.C2)
+	t10 := a.C1.mul(b.C0)
+	t11 := a.C1.mul(b.C1)
+	t12 := a.C1.mul(b.C2)
+	t20 := a.C2.mul(b.C0)
+	t21 := a.C2.mul(b.C1)
+	t22 := a.C2.mul(b.C2)
+
+	c0 := t00.add(refXi.mul(t12.add(t21)))
+	c1 := t01.add(t10).add(refXi.mul(t22))
+	c2 := t02.add(t11).add(t20)
+	return refFp6{c0, c1, c2}
+}
+
+func (a refFp6) square() refFp6 {
+	return a.mul(a)
+}
+
+// inverse uses the standard cubic-extension inversion formula (the same one
+// Field6.Inverse implements): it is textbook algebra, not the squaring
+// trick that had the bug, so it is safe to share as an independent check.
+func (a refFp6) inverse() refFp6 {
+	t0 := a.C0.mul(a.C0).sub(refXi.mul(a.C1.mul(a.C2)))
+	t1 := refXi.mul(a.C2.mul(a.C2)).sub(a.C0.mul(a.C1))
+	t2 := a.C1.mul(a.C1).sub(a.C0.mul(a.C2))
+
+	norm := a.C0.mul(t0).add(refXi.mul(a.C2.mul(t1).add(a.C1.mul(t2))))
+	normInv := norm.inverse()
+
+	return refFp6{t0.mul(normInv), t1.mul(normInv), t2.mul(normInv)}
+}
+
+func (a refFp6) toE6() E6[emulated.BN254Fp] {
+	c0, c1, c2 := a.C0.toE2(), a.C1.toE2(), a.C2.toE2()
+	return E6[emulated.BN254Fp]{C0: &c0, C1: &c1, C2: &c2}
+}
+
+type fp6OpCircuit struct {
+	A, B                          E6[emulated.BN254Fp]
+	ExpAdd, ExpMul, ExpSq, ExpInv E6[emulated.BN254Fp]
+}
+
+func (c *fp6OpCircuit) Define(api frontend.API) error {
+	fp, err := emulated.NewField[emulated.BN254Fp](api)
+	if err != nil {
+		return err
+	}
+	f2 := NewField2[emulated.BN254Fp](fp, testNonResidues{})
+	f6 := NewField6[emulated.BN254Fp](f2, testNonResidues{})
+	f6.AssertIsEqual(f6.Add(&c.A, &c.B), &c.ExpAdd)
+	f6.AssertIsEqual(f6.Mul(&c.A, &c.B), &c.ExpMul)
+	f6.AssertIsEqual(f6.Square(&c.A), &c.ExpSq)
+	f6.AssertIsEqual(f6.Inverse(&c.A), &c.ExpInv)
+	return nil
+}
+
+func TestField6Arithmetic(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		a, b := randRefFp6(), randRefFp6()
+		witness := &fp6OpCircuit{
+			A: a.toE6(), B: b.toE6(),
+			ExpAdd: a.add(b).toE6(),
+			ExpMul: a.mul(b).toE6(),
+			ExpSq:  a.square().toE6(),
+			ExpInv: a.inverse().toE6(),
+		}
+		assert.CheckCircuit(&fp6OpCircuit{}, test.WithValidAssignment(witness))
+	})
+}
+
+// refFp12 is Fp6[w]/(w²-v), where v is Fp6's degree-one basis element.
+type refFp12 struct{ C0, C1 refFp6 }
+
+var refV = refFp6{zeroFp2(), refFp2{big.NewInt(1), big.NewInt(0)}, zeroFp2()}
+
+func randRefFp12() refFp12 {
+	return refFp12{randRefFp6(), randRefFp6()}
+}
+
+func (a refFp12) add(b refFp12) refFp12 {
+	return refFp12{a.C0.add(b.C0), a.C1.add(b.C1)}
+}
+
+func (a refFp12) neg() refFp12 {
+	return refFp12{a.C0.neg(), a.C1.neg()}
+}
+
+func (a refFp12) sub(b refFp12) refFp12 {
+	return a.add(b.neg())
+}
+
+// mul computes a*b in Fp6[w]/(w²-v) via plain schoolbook expansion.
+func (a refFp12) mul(b refFp12) refFp12 {
+	t0 := a.C0.mul(b.C0)
+	t1 := a.C1.mul(b.C1)
+	c0 := t0.add(t1.mul(refV))
+	c1 := a.C0.mul(b.C1).add(a.C1.mul(b.C0))
+	return refFp12{c0, c1}
+}
+
+func (a refFp12) square() refFp12 {
+	return a.mul(a)
+}
+
+// inverse uses the standard quadratic-extension inversion formula over
+// Fp6, independent of Field12's complex-squaring-style Square.
+func (a refFp12) inverse() refFp12 {
+	t0 := a.C0.mul(a.C0)
+	t1 := a.C1.mul(a.C1)
+	norm := t0.sub(t1.mul(refV))
+	normInv := norm.inverse()
+	return refFp12{a.C0.mul(normInv), a.C1.neg().mul(normInv)}
+}
+
+func (a refFp12) toE12() E12[emulated.BN254Fp] {
+	c0, c1 := a.C0.toE6(), a.C1.toE6()
+	return E12[emulated.BN254Fp]{C0: &c0, C1: &c1}
+}
+
+type fp12OpCircuit struct {
+	A, B                          E12[emulated.BN254Fp]
+	ExpAdd, ExpMul, ExpSq, ExpInv E12[emulated.BN254Fp]
+}
+
+func (c *fp12OpCircuit) Define(api frontend.API) error {
+	fp, err := emulated.NewField[emulated.BN254Fp](api)
+	if err != nil {
+		return err
+	}
+	f2 := NewField2[emulated.BN254Fp](fp, testNonResidues{})
+	f6 := NewField6[emulated.BN254Fp](f2, testNonResidues{})
+	f12 := NewField12[emulated.BN254Fp](f6, testNonResidues{})
+	f12.AssertIsEqual(f12.Add(&c.A, &c.B), &c.ExpAdd)
+	f12.AssertIsEqual(f12.Mul(&c.A, &c.B), &c.ExpMul)
+	f12.AssertIsEqual(f12.Square(&c.A), &c.ExpSq)
+	f12.AssertIsEqual(f12.Inverse(&c.A), &c.ExpInv)
+	return nil
+}
+
+func TestField12Arithmetic(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		a, b := randRefFp12(), randRefFp12()
+		witness := &fp12OpCircuit{
+			A: a.toE12(), B: b.toE12(),
+			ExpAdd: a.add(b).toE12(),
+			ExpMul: a.mul(b).toE12(),
+			ExpSq:  a.square().toE12(),
+			ExpInv: a.inverse().toE12(),
+		}
+		assert.CheckCircuit(&fp12OpCircuit{}, test.WithValidAssignment(witness))
+	})
+}